@@ -7,7 +7,6 @@ import (
 	"math/rand"
 	"os"
 	"strings"
-	"unicode"
 
 	"github.com/76creates/stickers/flexbox"
 	"github.com/76creates/stickers/table"
@@ -106,87 +105,37 @@ func (m *model) Init() tea.Cmd { return nil }
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		windowHeight := msg.Height
-		windowWidth := msg.Width
-		m.flexBox.SetWidth(windowWidth)
-		m.flexBox.SetHeight(windowHeight)
-		m.table.SetWidth(windowWidth)
-		m.table.SetHeight(windowHeight)
+		// table size is taken from its flex box cell when rendering
+		m.flexBox.SetWidth(msg.Width)
+		m.flexBox.SetHeight(msg.Height)
+		return m, nil
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c":
 			return m, tea.Quit
-		case "down":
-			m.table.CursorDown()
-		case "up":
-			m.table.CursorUp()
-		case "left":
-			m.table.CursorLeft()
-		case "right":
-			m.table.CursorRight()
-		case "ctrl+s":
-			x, _ := m.table.GetCursorLocation()
-			_, order := m.table.GetOrder()
-			switch order {
-			case table.SortingOrderAscending:
-				m.table.OrderByDesc(x)
-			case table.SortingOrderDescending:
-				m.table.OrderByAsc(x)
-			}
-		case "enter", " ":
-			cellString := m.table.GetCursorValue()
-			// add content to random boxes on flex box
-			for ir := 0; ir < m.flexBox.RowsLen(); ir++ {
-				// don't' want it on the middle row
-				if ir == 1 {
-					continue
-				}
-				// not handling error for example script
-				for ic := 0; ic < m.flexBox.GetRow(ir).CellsLen(); ic++ {
-					// adding a bit of randomness for fun
-					if rand.Int()%2 == 0 {
-						h := int(math.Floor(float64(m.flexBox.GetRowCellCopy(ir, ic).GetHeight()) / 2.0))
-						m.flexBox.GetRow(ir).GetCell(ic).SetContent(strings.Repeat("\n", h) + cellString)
-					} else {
-						m.flexBox.GetRow(ir).GetCell(ic).SetContent("")
-					}
-				}
+		}
+	case table.SelectMsg:
+		// add content to random boxes on flex box
+		for ir := 0; ir < m.flexBox.RowsLen(); ir++ {
+			// don't' want it on the middle row
+			if ir == 1 {
+				continue
 			}
-		case "backspace":
-			m.filterWithStr(msg.String())
-		default:
-			if len(msg.String()) == 1 {
-				r := msg.Runes[0]
-				if unicode.IsLetter(r) || unicode.IsDigit(r) {
-					m.filterWithStr(msg.String())
+			// not handling error for example script
+			for ic := 0; ic < m.flexBox.GetRow(ir).CellsLen(); ic++ {
+				// adding a bit of randomness for fun
+				if rand.Int()%2 == 0 {
+					h := int(math.Floor(float64(m.flexBox.GetRowCellCopy(ir, ic).GetHeight()) / 2.0))
+					m.flexBox.GetRow(ir).GetCell(ic).SetContent(strings.Repeat("\n", h) + msg.Value)
+				} else {
+					m.flexBox.GetRow(ir).GetCell(ic).SetContent("")
 				}
 			}
 		}
-
-	}
-	return m, nil
-}
-
-func (m *model) filterWithStr(key string) {
-	i, s := m.table.GetFilter()
-	x, _ := m.table.GetCursorLocation()
-	if x != i && key != "backspace" {
-		m.table.SetFilter(x, key)
-		return
-	}
-	if key == "backspace" {
-		if len(s) == 1 {
-			m.table.UnsetFilter()
-			return
-		} else if len(s) > 1 {
-			s = s[0 : len(s)-1]
-		} else {
-			return
-		}
-	} else {
-		s = s + key
+		return m, nil
 	}
-	m.table.SetFilter(i, s)
+	_, cmd := m.table.Update(msg)
+	return m, cmd
 }
 
 func (m *model) View() string {
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/76creates/stickers/flexbox"
+	"github.com/76creates/stickers/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	firstNames  = []string{"Madaline", "Eddy", "Charlotte", "Maddie", "Eleanor", "Alford", "Kevin", "Tess"}
+	lastNames   = []string{"Watson", "Montgomery", "Armstrong", "Mitchell", "Douglas", "Andrews", "Owens"}
+	occupations = []string{"Physicist", "Hairdresser", "Historian", "Producer", "Jeweller", "Interior Designer"}
+)
+
+// countingSource counts the rows the table reads, sorting and filtering are pushed down
+// to the in-memory data source it wraps
+type countingSource struct {
+	*table.MemoryDataSource
+	reads int
+}
+
+// Row reads the row and counts the read
+func (s *countingSource) Row(index int) []any {
+	s.reads++
+	return s.MemoryDataSource.Row(index)
+}
+
+type model struct {
+	table   *table.Table
+	source  *countingSource
+	infoBox *flexbox.FlexBox
+}
+
+func main() {
+	// generate the rows, table reads only the rows in view so the size does not slow rendering down
+	rows := make([][]any, 500_000)
+	for i := range rows {
+		rows[i] = []any{
+			i + 1,
+			firstNames[i%len(firstNames)],
+			lastNames[i%len(lastNames)],
+			18 + i%50,
+			occupations[i%len(occupations)],
+		}
+	}
+	source := &countingSource{MemoryDataSource: table.NewMemoryDataSource(rows)}
+
+	columns := []table.Column{
+		{Header: "id", Type: 0, Ratio: 2, MinWidth: 7, Frozen: true},
+		{Header: "First Name", Type: "", Ratio: 10, MinWidth: 5},
+		{Header: "Last Name", Type: "", Ratio: 10, MinWidth: 5},
+		{Header: "Age", Type: 0, Ratio: 5, MinWidth: 2, MaxWidth: 8},
+		{Header: "Occupation", Type: "", Ratio: 10, MinWidth: 5},
+	}
+	t, err := table.NewTableWithDataSource(0, 0, columns, source)
+	if err != nil {
+		panic(err)
+	}
+	t.SetStylePassing(true)
+	t.SetFooterGenerator(table.RowCountFooter)
+
+	m := model{
+		table:   t,
+		source:  source,
+		infoBox: flexbox.New(0, 0).SetHeight(7),
+	}
+
+	// setup info box
+	infoText := `
+use the arrows to navigate, pgup/pgdown to scroll by page
+ctrl+s: sort by current column
+/: filter column, enter: apply filter, esc: clear filter
+ctrl+c: quit
+`
+	r1 := m.infoBox.NewRow()
+	r1.AddCells(
+		flexbox.NewCell(1, 1).
+			SetID("info").
+			SetContent(infoText),
+		flexbox.NewCell(1, 1).
+			SetID("reads").
+			SetStyle(lipgloss.NewStyle().Bold(true)),
+	)
+	m.infoBox.AddRows([]*flexbox.Row{r1})
+
+	p := tea.NewProgram(&m, tea.WithAltScreen())
+	if err := p.Start(); err != nil {
+		fmt.Printf("Alas, there's been an error: %v", err)
+		os.Exit(1)
+	}
+}
+
+func (m *model) Init() tea.Cmd { return nil }
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.infoBox.SetWidth(msg.Width)
+		// leave room for the info box below the table
+		msg.Height -= m.infoBox.GetHeight()
+		_, cmd := m.table.Update(msg)
+		return m, cmd
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "q":
+			// q is typed into the filter
+			if !m.table.IsFiltering() {
+				return m, tea.Quit
+			}
+		}
+	}
+	_, cmd := m.table.Update(msg)
+	return m, cmd
+}
+
+func (m *model) View() string {
+	tableView := m.table.Render()
+	// rows are read while rendering, so the count is updated after the table is rendered
+	m.infoBox.GetRow(0).GetCell(1).SetContent(fmt.Sprintf("\nrows read from the data source: %d", m.source.reads))
+	return lipgloss.JoinVertical(lipgloss.Left, tableView, m.infoBox.Render())
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"os"
-	"unicode"
 
 	"github.com/76creates/stickers/flexbox"
 	"github.com/76creates/stickers/table"
@@ -12,12 +11,19 @@ import (
 	"github.com/gocarina/gocsv"
 )
 
-var selectedValue string = "\nselect something with spacebar or enter"
+var selectedValue string = "\nselect something with enter"
+
+type SampleData struct {
+	ID         int    `csv:"id" table:"id,ratio=1,min=4,frozen"`
+	FirstName  string `csv:"First Name" table:"First Name,ratio=10,min=5"`
+	LastName   string `csv:"Last Name" table:"Last Name,ratio=10,min=5"`
+	Age        int    `csv:"Age" table:"Age,ratio=5,min=2,max=8"`
+	Occupation string `csv:"Occupation" table:"Occupation,ratio=10,min=5"`
+}
 
 type model struct {
-	table   *table.Table
+	table   *table.StructTable[*SampleData]
 	infoBox *flexbox.FlexBox
-	headers []string
 }
 
 func main() {
@@ -28,57 +34,34 @@ func main() {
 	}
 	defer f.Close()
 
-	type SampleData struct {
-		ID         int    `csv:"id"`
-		FirstName  string `csv:"First Name"`
-		LastName   string `csv:"Last Name"`
-		Age        int    `csv:"Age"`
-		Occupation string `csv:"Occupation"`
-	}
 	var sampleData []*SampleData
 
 	if err := gocsv.UnmarshalFile(f, &sampleData); err != nil {
 		panic(err)
 	}
 
-	headers := []string{"id", "First Name", "Last Name", "Age", "Occupation"}
-	ratio := []int{1, 10, 10, 5, 10}
-	minSize := []int{4, 5, 5, 2, 5}
-
-	var s string
-	var i int
-	types := []any{i, s, s, i, s}
-
-	m := model{
-		table:   table.NewTable(0, 0, headers),
-		infoBox: flexbox.New(0, 0).SetHeight(7),
-		headers: headers,
-	}
-	// set types
-	_, err = m.table.SetTypes(types...)
+	// columns are derived from the struct tags, header, type and dimensions in one place
+	t, err := table.FromStructs(sampleData)
 	if err != nil {
 		panic(err)
 	}
-	// setup dimensions
-	m.table.SetRatio(ratio).SetMinWidth(minSize)
+
+	m := model{
+		table:   t,
+		infoBox: flexbox.New(0, 0).SetHeight(9),
+	}
 	// set style passing
 	m.table.SetStylePassing(true)
-	// add rows
-	// with multi type table we have to convert our rows to []any first which is a bit of a pain
-	var orderedRows [][]any
-	for _, row := range sampleData {
-		orderedRows = append(orderedRows, []any{
-			row.ID, row.FirstName, row.LastName, row.Age, row.Occupation,
-		})
-	}
-	m.table.MustAddRows(orderedRows)
+	m.table.SetFooterGenerator(table.RowCountFooter)
 
 	// setup info box
 	infoText := `
 use the arrows to navigate
 ctrl+s: sort by current column
-alphanumerics: filter column
-enter, spacebar: get column value
+/: filter column, enter: apply filter, esc: clear filter
+enter: get column value, e: edit cell
+spacebar: mark row, shift+↑/↓: mark range, ctrl+a: mark all, ctrl+n: unmark all
+-/+: hide column/show all, shift+←/→: move column, ctrl+o: restore column order
 ctrl+c: quit
 `
 	r1 := m.infoBox.NewRow()
@@ -105,68 +88,29 @@ func (m *model) Init() tea.Cmd { return nil }
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.table.SetWidth(msg.Width)
-		m.table.SetHeight(msg.Height - m.infoBox.GetHeight())
 		m.infoBox.SetWidth(msg.Width)
+		// leave room for the info box below the table
+		msg.Height -= m.infoBox.GetHeight()
+		_, cmd := m.table.Update(msg)
+		return m, cmd
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c":
 			return m, tea.Quit
-		case "down":
-			m.table.CursorDown()
-		case "up":
-			m.table.CursorUp()
-		case "left":
-			m.table.CursorLeft()
-		case "right":
-			m.table.CursorRight()
-		case "ctrl+s":
-			x, _ := m.table.GetCursorLocation()
-			_, order := m.table.GetOrder()
-			switch order {
-			case table.SortingOrderAscending:
-				m.table.OrderByDesc(x)
-			case table.SortingOrderDescending:
-				m.table.OrderByAsc(x)
-			}
-		case "enter", " ":
-			selectedValue = m.table.GetCursorValue()
-			m.infoBox.GetRow(0).GetCell(1).SetContent("\nselected cell: " + selectedValue)
-		case "backspace":
-			m.filterWithStr(msg.String())
-		default:
-			if len(msg.String()) == 1 {
-				r := msg.Runes[0]
-				if unicode.IsLetter(r) || unicode.IsDigit(r) {
-					m.filterWithStr(msg.String())
-				}
-			}
 		}
-
-	}
-	return m, nil
-}
-
-func (m *model) filterWithStr(key string) {
-	i, s := m.table.GetFilter()
-	x, _ := m.table.GetCursorLocation()
-	if x != i && key != "backspace" {
-		m.table.SetFilter(x, key)
-		return
-	}
-	if key == "backspace" {
-		if len(s) == 1 {
-			m.table.UnsetFilter()
-			return
-		} else if len(s) > 1 {
-			s = s[0 : len(s)-1]
-		} else {
-			return
+	case table.SelectMsg:
+		selectedValue = msg.Value
+		if row, ok := m.table.GetCursorRow(); ok {
+			selectedValue = fmt.Sprintf("%s (%s %s)", selectedValue, row.FirstName, row.LastName)
 		}
-	} else {
-		s = s + key
+		m.infoBox.GetRow(0).GetCell(1).SetContent("\nselected cell: " + selectedValue)
+		return m, nil
+	case table.SelectionMsg:
+		m.infoBox.GetRow(0).GetCell(1).SetContent(fmt.Sprintf("\nmarked rows: %d", len(msg.Keys)))
+		return m, nil
 	}
-	m.table.SetFilter(i, s)
+	_, cmd := m.table.Update(msg)
+	return m, cmd
 }
 
 func (m *model) View() string {
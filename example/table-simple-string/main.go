@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"unicode"
 
 	"github.com/76creates/stickers/flexbox"
 	"github.com/76creates/stickers/table"
@@ -13,7 +12,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-var selectedValue string = "\nselect something with spacebar or enter"
+var selectedValue string = "\nselect something with enter"
 
 type model struct {
 	table   *table.Table
@@ -48,7 +47,7 @@ func main() {
 
 	m := model{
 		table:   table.NewTable(0, 0, headers),
-		infoBox: flexbox.New(0, 0).SetHeight(7),
+		infoBox: flexbox.New(0, 0).SetHeight(9),
 		headers: headers,
 	}
 	m.table.SetStylePassing(true)
@@ -63,8 +62,10 @@ func main() {
 	infoText := `
 use the arrows to navigate
 ctrl+s: sort by current column
-alphanumerics: filter column
-enter, spacebar: get column value
+/: filter column, enter: apply filter, esc: clear filter
+enter: get column value, e: edit cell
+spacebar: mark row, shift+↑/↓: mark range, ctrl+a: mark all, ctrl+n: unmark all
+-/+: hide column/show all, shift+←/→: move column, ctrl+o: restore column order
 ctrl+c: quit
 `
 	r1 := m.infoBox.NewRow()
@@ -91,68 +92,28 @@ func (m *model) Init() tea.Cmd { return nil }
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.table.SetWidth(msg.Width)
-		m.table.SetHeight(msg.Height - m.infoBox.GetHeight())
 		m.infoBox.SetWidth(msg.Width)
+		// leave room for the info box below the table
+		msg.Height -= m.infoBox.GetHeight()
+		_, cmd := m.table.Update(msg)
+		return m, cmd
 	case tea.KeyMsg:
 		switch msg.String() {
-		case "ctrl+c", "q":
+		case "ctrl+c":
 			return m, tea.Quit
-		case "down":
-			m.table.CursorDown()
-		case "up":
-			m.table.CursorUp()
-		case "left":
-			m.table.CursorLeft()
-		case "right":
-			m.table.CursorRight()
-		case "ctrl+s":
-			x, _ := m.table.GetCursorLocation()
-			_, order := m.table.GetOrder()
-			switch order {
-			case table.SortingOrderAscending:
-				m.table.OrderByDesc(x)
-			case table.SortingOrderDescending:
-				m.table.OrderByAsc(x)
+		case "q":
+			// q is typed into the filter or the editor
+			if !m.table.IsFiltering() && !m.table.IsEditing() {
+				return m, tea.Quit
 			}
-		case "enter", " ":
-			selectedValue = m.table.GetCursorValue()
-			m.infoBox.GetRow(0).GetCell(1).SetContent("\nselected cell: " + selectedValue)
-		case "backspace":
-			m.filterWithStr(msg.String())
-		default:
-			if len(msg.String()) == 1 {
-				r := msg.Runes[0]
-				if unicode.IsLetter(r) || unicode.IsDigit(r) {
-					m.filterWithStr(msg.String())
-				}
-			}
-		}
-
-	}
-	return m, nil
-}
-
-func (m *model) filterWithStr(key string) {
-	i, s := m.table.GetFilter()
-	x, _ := m.table.GetCursorLocation()
-	if x != i && key != "backspace" {
-		m.table.SetFilter(x, key)
-		return
-	}
-	if key == "backspace" {
-		if len(s) == 1 {
-			m.table.UnsetFilter()
-			return
-		} else if len(s) > 1 {
-			s = s[0 : len(s)-1]
-		} else {
-			return
 		}
-	} else {
-		s = s + key
+	case table.SelectMsg:
+		selectedValue = msg.Value
+		m.infoBox.GetRow(0).GetCell(1).SetContent("\nselected cell: " + selectedValue)
+		return m, nil
 	}
-	m.table.SetFilter(i, s)
+	_, cmd := m.table.Update(msg)
+	return m, cmd
 }
 
 func (m *model) View() string {
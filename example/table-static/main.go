@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/76creates/stickers/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func main() {
+	// read in CSV data, column types are inferred from the values
+	f, err := os.Open("../sample.csv")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	t, err := table.FromCSV(f, table.CSVOptions{})
+	if err != nil {
+		panic(err)
+	}
+	t.OrderByAsc(3)
+	if _, err := t.SetFilter(3, "<30"); err != nil {
+		panic(err)
+	}
+
+	// print the table without bubbletea, colors and border are replaced with ASCII when piped
+	fmt.Println(t.RenderStatic(table.StaticOptions{
+		MaxWidth: 80,
+		Border:   lipgloss.RoundedBorder(),
+	}))
+}
@@ -20,6 +20,8 @@ type Cell struct {
 	ratioY int
 	// minWidth minimal width of the cell
 	minWidth int
+	// maxWidth maximal width of the cell, 0 means there is no maximum
+	maxWidth int
 	// minHeight minimal height of the cell
 	minHeight int
 
@@ -74,6 +76,14 @@ func (r *Cell) SetMinWidth(value int) *Cell {
 	return r
 }
 
+// SetMaxWidth sets the cells maximum width, the width the cell would take over the maximum is
+// distributed to the other cells in the row. 0 removes the maximum.
+// This has only an effect to cells of a normal FlexBox, not a HorizontalFlexBox.
+func (r *Cell) SetMaxWidth(value int) *Cell {
+	r.maxWidth = value
+	return r
+}
+
 // Deprecated: use [*Cell.SetMinHeight]
 func (r *Cell) SetMinHeigth(value int) *Cell {
 	return r.SetMinHeight(value)
@@ -173,14 +173,18 @@ func (r *Row) calculateCellsDimensions() (xMatrix, yMatrix []int) {
 	// reminder not needed here due to how combined ratio is passed
 	yMatrix, _ = distributeToMatrix(r.getContentHeight(), cellYMatrixMax, cellYMatrix)
 
-	// get the min width matrix of the cells if any
-	withMinWidth := false
-	var minWidthMatrix []int
+	// get the min and max width matrix of the cells if any
+	withMinWidth, withMaxWidth := false, false
+	var minWidthMatrix, maxWidthMatrix []int
 	for _, c := range r.cells {
 		minWidthMatrix = append(minWidthMatrix, c.minWidth)
+		maxWidthMatrix = append(maxWidthMatrix, c.maxWidth)
 		if c.minWidth > 0 {
 			withMinWidth = true
 		}
+		if c.maxWidth > 0 {
+			withMaxWidth = true
+		}
 	}
 
 	// calculate the cell width matrix
@@ -189,6 +193,9 @@ func (r *Row) calculateCellsDimensions() (xMatrix, yMatrix []int) {
 	} else {
 		xMatrix = calculateRatio(r.getContentWidth(), r.getCellWidthMatrix())
 	}
+	if withMaxWidth {
+		xMatrix = limitToMaximum(xMatrix, r.getCellWidthMatrix(), maxWidthMatrix)
+	}
 
 	return xMatrix, yMatrix
 }
@@ -35,6 +35,36 @@ func calculateRatioWithMinimum(distribute int, matrix []int, minimumMatrix []int
 	return ratioDistribution
 }
 
+// limitToMaximum shrinks the distribution to the maximum values and distributes the excess to the values
+// that are not limited by their ratio, if all the values are limited the excess is left out
+func limitToMaximum(distribution []int, matrix []int, maximumMatrix []int) []int {
+	ratios := make([]int, len(matrix))
+	copy(ratios, matrix)
+	for {
+		excess := 0
+		for i, d := range distribution {
+			if maximumMatrix[i] > 0 && d >= maximumMatrix[i] {
+				excess += d - maximumMatrix[i]
+				distribution[i] = maximumMatrix[i]
+				ratios[i] = 0
+			}
+		}
+		if excess == 0 {
+			return distribution
+		}
+		var combinedRatios int
+		for _, ratio := range ratios {
+			combinedRatios += ratio
+		}
+		if combinedRatios == 0 {
+			return distribution
+		}
+		for i, d := range calculateRatio(excess, ratios) {
+			distribution[i] += d
+		}
+	}
+}
+
 func calculateRatio(distribute int, matrix []int) (ratioDistribution []int) {
 	if distribute == 0 {
 		for range matrix {
@@ -0,0 +1,98 @@
+package table
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// autoFitPadding is the space left after the widest cell so the columns don't touch
+	autoFitPadding = 1
+	// autoFitHeaderSuffix is the space reserved after the header for the sort symbol
+	autoFitHeaderSuffix = 2
+)
+
+// SetAutoFit turns the auto-fit sizing mode on or off, while on ratio and min width of each column
+// are measured from the header and cell widths whenever the rows change, max width of the column is respected.
+// Turning it off keeps the last measured widths
+func (r *Table) SetAutoFit(value bool) *Table {
+	r.autoFit = value
+	if value {
+		r.setAutoFitUpdate()
+	}
+	return r
+}
+
+// IsAutoFit returns true if the auto-fit sizing mode is on
+func (r *Table) IsAutoFit() bool {
+	return r.autoFit
+}
+
+// SetAutoFitSampleSize limits the number of rows measured when auto-fitting, rows are sampled evenly
+// across the table, 0 measures all the rows
+func (r *Table) SetAutoFitSampleSize(value int) *Table {
+	r.autoFitSampleSize = max(0, value)
+	r.setAutoFitUpdate()
+	return r
+}
+
+// AutoFitColumns measures and sets ratio and min width of all the columns once
+func (r *Table) AutoFitColumns() *Table {
+	for i := range r.columns {
+		r.fitColumn(i)
+	}
+	r.recalculateVisibleColumnRange()
+	return r
+}
+
+// AutoFitColumn measures and sets ratio and min width of the column with the index
+func (r *Table) AutoFitColumn(index int) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	r.fitColumn(index)
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
+
+// AutoFitCursorColumn measures and sets ratio and min width of the column under the cursor
+func (r *Table) AutoFitCursorColumn() *Table {
+	_, _ = r.AutoFitColumn(r.cursorIndexX)
+	return r
+}
+
+// setAutoFitUpdate marks the widths to be measured on the next render when auto-fit is on
+func (r *Table) setAutoFitUpdate() {
+	r.autoFitFlag = r.autoFit
+}
+
+// updateAutoFit measures the widths of all the columns if the rows changed since the last measure
+func (r *Table) updateAutoFit() {
+	if !r.autoFitFlag {
+		return
+	}
+	r.AutoFitColumns()
+	r.autoFitFlag = false
+}
+
+// fitColumn sets ratio and min width of the column to the measured width
+func (r *Table) fitColumn(index int) {
+	width := r.measureColumn(index)
+	if maxWidth := r.columns[index].MaxWidth; maxWidth > 0 && width > maxWidth {
+		width = maxWidth
+	}
+	r.columns[index].MinWidth = width
+	r.columns[index].Ratio = max(1, width)
+}
+
+// measureColumn returns the display width needed to show the header and cells of the column
+func (r *Table) measureColumn(index int) int {
+	width := lipgloss.Width(r.columns[index].Header) + autoFitHeaderSuffix
+	step := 1
+	if r.autoFitSampleSize > 0 && len(r.rows) > r.autoFitSampleSize {
+		step = len(r.rows) / r.autoFitSampleSize
+	}
+	for i := 0; i < len(r.rows); i += step {
+		width = max(width, lipgloss.Width(r.formatCell(index, r.rows[i][index])))
+	}
+	return width + autoFitPadding
+}
@@ -0,0 +1,439 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Alignment is the horizontal alignment of the column header and cells
+type Alignment int
+
+const (
+	// AlignAuto aligns the numeric columns to the right and the rest to the left, this is the default
+	AlignAuto Alignment = iota
+	AlignLeft
+	AlignCenter
+	AlignRight
+)
+
+// Formatter renders the value of the cell into the string shown in the table
+type Formatter func(value any) string
+
+// Column defines a single column of the table
+type Column struct {
+	Header string
+	// Type is a value of the type the cells of the column hold, e.g. 0 for int or "" for string,
+	// it has to be one of Ordered interface types, bool, time.Time, time.Duration or a CellValue,
+	// string is used when it's nil
+	Type any
+	// Ratio is the width ratio of the column relative to the other columns, 1 is used when it's 0
+	Ratio int
+	// MinWidth and MaxWidth limit the width of the column, MaxWidth 0 means there is no limit
+	MinWidth int
+	MaxWidth int
+	Align    Alignment
+	// Formatter renders the cells of the column, if nil cells are rendered by their type
+	Formatter Formatter
+	// Comparator is used when sorting by the column with a sort key that has no comparator
+	Comparator Comparator
+	// Hidden columns are not rendered and the cursor skips them, their cells can still be filtered on
+	Hidden bool
+	// Frozen columns are rendered on the left of the table and stay in view when scrolling horizontally
+	Frozen bool
+}
+
+// NewTableWithColumns initialize Table object with the columns, columns are validated and missing
+// values are set to the defaults, error is of type ErrorBadColumn if any of the columns is not valid
+func NewTableWithColumns(width, height int, columns []Column) (*Table, error) {
+	columns, err := normalizeColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+	return newTable(width, height, columns), nil
+}
+
+// GetColumns returns the copy of the column definitions
+func (r *Table) GetColumns() []Column {
+	return append([]Column(nil), r.columns...)
+}
+
+// GetColumnHeaders returns the headers of the columns
+func (r *Table) GetColumnHeaders() []string {
+	headers := make([]string, len(r.columns))
+	for i, c := range r.columns {
+		headers[i] = c.Header
+	}
+	return headers
+}
+
+// normalizeColumns validates the columns and returns the copy with the defaults set
+func normalizeColumns(columns []Column) ([]Column, error) {
+	normalized := make([]Column, len(columns))
+	hidden := 0
+	for i, c := range columns {
+		if c.Type == nil {
+			c.Type = ""
+		}
+		if !isOrdered(c.Type) {
+			return nil, ErrorBadColumn{msg: fmt.Sprintf(
+				"column of type %s on index %d is not one of the supported cell types", reflect.TypeOf(c.Type).String(), i,
+			)}
+		}
+		if c.Ratio == 0 {
+			c.Ratio = 1
+		}
+		if c.Ratio < 0 {
+			return nil, ErrorBadColumn{msg: fmt.Sprintf("ratio of the column on index %d must be greater than 0", i)}
+		}
+		if c.MinWidth < 0 || c.MaxWidth < 0 {
+			return nil, ErrorBadColumn{msg: fmt.Sprintf("width limits of the column on index %d can not be negative", i)}
+		}
+		if c.MaxWidth > 0 && c.MaxWidth < c.MinWidth {
+			return nil, ErrorBadColumn{msg: fmt.Sprintf(
+				"max width[%d] of the column on index %d is lower than min width[%d]", c.MaxWidth, i, c.MinWidth,
+			)}
+		}
+		if c.Align < AlignAuto || c.Align > AlignRight {
+			return nil, ErrorBadColumn{msg: fmt.Sprintf("alignment of the column on index %d is not valid", i)}
+		}
+		if c.Hidden {
+			hidden++
+		}
+		normalized[i] = c
+	}
+	if len(columns) > 0 && hidden == len(columns) {
+		return nil, ErrorBadColumn{msg: "at least one column has to be visible"}
+	}
+	return normalized, nil
+}
+
+// position returns the lipgloss position of the alignment
+func (a Alignment) position() lipgloss.Position {
+	switch a {
+	case AlignCenter:
+		return lipgloss.Center
+	case AlignRight:
+		return lipgloss.Right
+	default:
+		return lipgloss.Left
+	}
+}
+
+// formatCell renders the value of the cell with the formatter of the column
+func (r *Table) formatCell(columnIndex int, value any) string {
+	if f := r.columns[columnIndex].Formatter; f != nil {
+		return f(value)
+	}
+	return getStringFromOrdered(value)
+}
+
+// alignCell pads the content to the width according to the alignment of the column, the last
+// character of the width is kept empty so the content does not touch the next column
+func (r *Table) alignCell(columnIndex int, content string, width int) string {
+	align := r.columnAlignment(columnIndex)
+	if align == AlignLeft || width < 2 {
+		return content
+	}
+	return lipgloss.PlaceHorizontal(width-1, align.position(), content) + " "
+}
+
+// columnAlignment returns the alignment of the column with AlignAuto resolved by the column type
+func (r *Table) columnAlignment(columnIndex int) Alignment {
+	align := r.columns[columnIndex].Align
+	if align != AlignAuto {
+		return align
+	}
+	if _, ok := r.columns[columnIndex].Type.(time.Duration); ok {
+		return AlignRight
+	}
+	if isNumeric(r.columns[columnIndex].Type) {
+		return AlignRight
+	}
+	return AlignLeft
+}
+
+// SetColumnFormatter sets the formatter of the column with the index, nil renders the cells by their type
+func (r *Table) SetColumnFormatter(index int, formatter Formatter) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	r.columns[index].Formatter = formatter
+	r.setAutoFitUpdate()
+	if r.filterValueMode == ValueFormatted {
+		r.setFiltersUpdate()
+	}
+	r.setRowsUpdate()
+	return r, nil
+}
+
+// SetColumnAlignment sets the alignment of the header and cells of the column with the index
+func (r *Table) SetColumnAlignment(index int, align Alignment) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	if align < AlignAuto || align > AlignRight {
+		return r, ErrorBadColumn{msg: fmt.Sprintf("alignment of the column on index %d is not valid", index)}
+	}
+	r.columns[index].Align = align
+	r.setHeadersUpdate()
+	r.setRowsUpdate()
+	return r, nil
+}
+
+// columnPosition returns the position the column with the index is rendered on
+func (r *Table) columnPosition(index int) int {
+	return slices.Index(r.columnOrder, index)
+}
+
+// nextVisiblePosition returns the first position with a column that is not hidden starting from the position
+// and moving by the step, -1 if there is none
+func (r *Table) nextVisiblePosition(position, step int) int {
+	for p := position; p >= 0 && p < len(r.columnOrder); p += step {
+		if !r.columns[r.columnOrder[p]].Hidden {
+			return p
+		}
+	}
+	return -1
+}
+
+// nextVisibleColumn returns the index of the first column that is not hidden starting from the column
+// with the index and moving by the step in the rendered order, -1 if there is none
+func (r *Table) nextVisibleColumn(index, step int) int {
+	position := r.columnPosition(index)
+	if position == -1 {
+		return -1
+	}
+	if p := r.nextVisiblePosition(position+step, step); p > -1 {
+		return r.columnOrder[p]
+	}
+	return -1
+}
+
+// firstVisibleColumn returns the index of the first column that is not hidden in the rendered order,
+// -1 if there is none
+func (r *Table) firstVisibleColumn() int {
+	if p := r.nextVisiblePosition(0, 1); p > -1 {
+		return r.columnOrder[p]
+	}
+	return -1
+}
+
+// HideColumn hides the column with the index, if the cursor is on the column it moves to the next visible column,
+// the last visible column can not be hidden
+func (r *Table) HideColumn(index int) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	if r.columns[index].Hidden {
+		return r, nil
+	}
+	next := r.nextVisibleColumn(index, 1)
+	if next == -1 {
+		next = r.nextVisibleColumn(index, -1)
+	}
+	if next == -1 {
+		return r, ErrorBadColumn{msg: "at least one column has to be visible"}
+	}
+	r.columns[index].Hidden = true
+	if r.cursorIndexX == index {
+		r.cursorIndexX = next
+	}
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
+
+// HideCursorColumn hides the column under the cursor
+func (r *Table) HideCursorColumn() (*Table, error) {
+	return r.HideColumn(r.cursorIndexX)
+}
+
+// ShowColumn unhides the column with the index
+func (r *Table) ShowColumn(index int) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	r.columns[index].Hidden = false
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
+
+// ShowAllColumns unhides all the columns
+func (r *Table) ShowAllColumns() *Table {
+	for i := range r.columns {
+		r.columns[i].Hidden = false
+	}
+	r.recalculateVisibleColumnRange()
+	return r
+}
+
+// IsColumnHidden returns true if the column with the index is hidden
+func (r *Table) IsColumnHidden(index int) bool {
+	return index >= 0 && index < len(r.columns) && r.columns[index].Hidden
+}
+
+// MoveColumnLeft moves the column with the index in front of the visible column rendered to its left,
+// moving changes only the rendered order, column indexes used by rows, filters, sorting and cursor stay the same,
+// frozen columns can not be moved past the scrolled ones and vice versa
+func (r *Table) MoveColumnLeft(index int) (*Table, error) {
+	return r.moveColumn(index, -1)
+}
+
+// MoveColumnRight moves the column with the index behind the visible column rendered to its right,
+// moving changes only the rendered order, column indexes used by rows, filters, sorting and cursor stay the same,
+// frozen columns can not be moved past the scrolled ones and vice versa
+func (r *Table) MoveColumnRight(index int) (*Table, error) {
+	return r.moveColumn(index, 1)
+}
+
+// MoveCursorColumnLeft moves the column under the cursor to the left
+func (r *Table) MoveCursorColumnLeft() *Table {
+	_, _ = r.MoveColumnLeft(r.cursorIndexX)
+	return r
+}
+
+// MoveCursorColumnRight moves the column under the cursor to the right
+func (r *Table) MoveCursorColumnRight() *Table {
+	_, _ = r.MoveColumnRight(r.cursorIndexX)
+	return r
+}
+
+// RestoreColumnOrder renders the columns in the order they were defined in, frozen columns stay in front
+func (r *Table) RestoreColumnOrder() *Table {
+	r.columnOrder = sequence(0, len(r.columns))
+	r.partitionFrozen()
+	r.recalculateVisibleColumnRange()
+	return r
+}
+
+// SetFrozenColumns freezes the first n columns in the rendered order and unfreezes the rest,
+// frozen columns are rendered on the left and stay in view when scrolling horizontally
+func (r *Table) SetFrozenColumns(n int) (*Table, error) {
+	if n < 0 || n > len(r.columns) {
+		return r, ErrorBadColumnIndex{msg: fmt.Sprintf("number of frozen columns %d out of range", n)}
+	}
+	for position, index := range r.columnOrder {
+		r.columns[index].Frozen = position < n
+	}
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
+
+// FreezeColumn freezes the column with the index, it's moved behind the already frozen columns
+func (r *Table) FreezeColumn(index int) (*Table, error) {
+	return r.setFrozen(index, true)
+}
+
+// UnfreezeColumn unfreezes the column with the index, it's moved in front of the scrolled columns
+func (r *Table) UnfreezeColumn(index int) (*Table, error) {
+	return r.setFrozen(index, false)
+}
+
+// IsColumnFrozen returns true if the column with the index is frozen
+func (r *Table) IsColumnFrozen(index int) bool {
+	return index >= 0 && index < len(r.columns) && r.columns[index].Frozen
+}
+
+// setFrozen sets the frozen flag of the column and moves it to the edge of the frozen columns
+func (r *Table) setFrozen(index int, frozen bool) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	if r.columns[index].Frozen == frozen {
+		return r, nil
+	}
+	from := r.columnPosition(index)
+	r.columnOrder = slices.Delete(r.columnOrder, from, from+1)
+	r.columns[index].Frozen = frozen
+	// frozen count already includes the column when freezing and excludes it when unfreezing
+	to := r.frozenCount()
+	if frozen {
+		to--
+	}
+	r.columnOrder = slices.Insert(r.columnOrder, to, index)
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
+
+// partitionFrozen moves the frozen columns in front of the rendered order keeping their relative order
+func (r *Table) partitionFrozen() {
+	slices.SortStableFunc(r.columnOrder, func(a, b int) int {
+		if r.columns[a].Frozen == r.columns[b].Frozen {
+			return 0
+		}
+		if r.columns[a].Frozen {
+			return -1
+		}
+		return 1
+	})
+}
+
+// frozenCount returns the number of frozen columns, they take the first positions of the rendered order
+func (r *Table) frozenCount() int {
+	n := 0
+	for _, c := range r.columns {
+		if c.Frozen {
+			n++
+		}
+	}
+	return n
+}
+
+// frozenWidth returns the minimal width of the frozen columns that are not hidden
+func (r *Table) frozenWidth() int {
+	width := 0
+	for _, c := range r.columns {
+		if c.Frozen && !c.Hidden {
+			width += c.MinWidth
+		}
+	}
+	return width
+}
+
+// renderedColumns returns the indexes of the columns that are rendered, frozen columns followed by the
+// scrolled columns up to the rightmost position
+func (r *Table) renderedColumns(rightmost int) []int {
+	var indexes []int
+	frozen := r.frozenCount()
+	for position, index := range r.columnOrder {
+		if position >= frozen && (position < r.columnVisibleLeftIndex || position > rightmost) {
+			continue
+		}
+		if !r.columns[index].Hidden {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// GetColumnOrder returns the indexes of the columns in the order they are rendered in, hidden columns included
+func (r *Table) GetColumnOrder() []int {
+	return slices.Clone(r.columnOrder)
+}
+
+// checkColumnIndex returns ErrorBadColumnIndex if the index is out of range of the columns
+func (r *Table) checkColumnIndex(index int) error {
+	if index < 0 || index >= len(r.columns) {
+		return ErrorBadColumnIndex{msg: fmt.Sprintf("column index %d out of range", index)}
+	}
+	return nil
+}
+
+// moveColumn moves the column with the index past the next visible column in the direction of the step,
+// frozen columns are moved only among the frozen ones and the rest only among the scrolled ones
+func (r *Table) moveColumn(index, step int) (*Table, error) {
+	if err := r.checkColumnIndex(index); err != nil {
+		return r, err
+	}
+	from := r.columnPosition(index)
+	to := r.nextVisiblePosition(from+step, step)
+	if to == -1 || r.columns[r.columnOrder[to]].Frozen != r.columns[index].Frozen {
+		return r, nil
+	}
+	r.columnOrder = slices.Insert(slices.Delete(r.columnOrder, from, from+1), to, index)
+	r.recalculateVisibleColumnRange()
+	return r, nil
+}
@@ -0,0 +1,83 @@
+package table
+
+import (
+	"strings"
+)
+
+// Comparator compares two cells of a column, returns a negative number if a is less than b,
+// zero if they are equal and a positive number if a is greater than b
+type Comparator func(a, b any) int
+
+// CompareCaseInsensitive compares the string values of the cells ignoring the case
+func CompareCaseInsensitive(a, b any) int {
+	return strings.Compare(
+		strings.ToLower(getStringFromOrdered(a)),
+		strings.ToLower(getStringFromOrdered(b)),
+	)
+}
+
+// CompareNatural compares the string values of the cells so that the numbers within them
+// are compared by value, e.g. "file2" is less than "file10"
+func CompareNatural(a, b any) int {
+	return compareNatural(getStringFromOrdered(a), getStringFromOrdered(b))
+}
+
+// CompareNaturalCaseInsensitive is CompareNatural ignoring the case
+func CompareNaturalCaseInsensitive(a, b any) int {
+	return compareNatural(
+		strings.ToLower(getStringFromOrdered(a)),
+		strings.ToLower(getStringFromOrdered(b)),
+	)
+}
+
+// compareNatural splits the strings into digit and non-digit chunks and compares them chunk by chunk,
+// digit chunks are compared by value, if values are equal the one with fewer leading zeros comes first
+func compareNatural(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	for len(ra) > 0 && len(rb) > 0 {
+		ca, restA := nextNaturalChunk(ra)
+		cb, restB := nextNaturalChunk(rb)
+		ra, rb = restA, restB
+
+		digitsA, digitsB := isDigit(ca[0]), isDigit(cb[0])
+		if digitsA && digitsB {
+			if c := compareDigits(ca, cb); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(string(ca), string(cb)); c != 0 {
+			return c
+		}
+	}
+	return len(ra) - len(rb)
+}
+
+// nextNaturalChunk returns the leading run of digits or non-digits and the rest of the runes
+func nextNaturalChunk(r []rune) ([]rune, []rune) {
+	digits := isDigit(r[0])
+	i := 1
+	for i < len(r) && isDigit(r[i]) == digits {
+		i++
+	}
+	return r[:i], r[i:]
+}
+
+// compareDigits compares two runs of digits by value without parsing so any length is supported
+func compareDigits(a, b []rune) int {
+	trimmedA := strings.TrimLeft(string(a), "0")
+	trimmedB := strings.TrimLeft(string(b), "0")
+	if len(trimmedA) != len(trimmedB) {
+		return len(trimmedA) - len(trimmedB)
+	}
+	if c := strings.Compare(trimmedA, trimmedB); c != 0 {
+		return c
+	}
+	// equal values, fewer leading zeros first
+	return len(a) - len(b)
+}
+
+// isDigit checks for ASCII digits only, other scripts are compared as text
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
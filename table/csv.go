@@ -0,0 +1,175 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions configure reading the table with FromCSV and writing it with WriteCSV
+type CSVOptions struct {
+	// Comma is the field delimiter, ',' is used when it's 0, set it to '\t' for TSV
+	Comma rune
+	// NoHeader is set when the first record holds data and not the headers, FromCSV names the columns
+	// "Column 1", "Column 2"... and WriteCSV does not write the header record
+	NoHeader bool
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes in quoted fields when reading
+	LazyQuotes bool
+	// Rows chooses which rows WriteCSV writes
+	Rows RowSet
+	// Values chooses if WriteCSV writes the raw values or the values rendered by the column formatters
+	Values ValueMode
+	// AllColumns makes WriteCSV write the hidden columns as well, in the order the columns were defined in
+	AllColumns bool
+}
+
+// FromCSV initialize Table object from CSV, type of each column is inferred from its values, columns where
+// all the values are integers are of type int, columns where all the values are numbers are of type float64
+// and the rest are of type string, blank values do not change the inferred type
+func FromCSV(reader io.Reader, opts CSVOptions) (*Table, error) {
+	csvReader := csv.NewReader(reader)
+	if opts.Comma != 0 {
+		csvReader.Comma = opts.Comma
+	}
+	csvReader.LazyQuotes = opts.LazyQuotes
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrorBadColumn{msg: "csv has no records to read the columns from"}
+	}
+
+	columns := make([]Column, len(records[0]))
+	for i := range columns {
+		columns[i].Header = fmt.Sprintf("Column %d", i+1)
+	}
+	if !opts.NoHeader {
+		for i, header := range records[0] {
+			columns[i].Header = header
+		}
+		records = records[1:]
+	}
+
+	rows := make([][]any, len(records))
+	for i := range rows {
+		rows[i] = make([]any, len(columns))
+	}
+	for i := range columns {
+		columns[i].Type = inferColumn(records, rows, i)
+	}
+
+	t, err := NewTableWithColumns(0, 0, columns)
+	if err != nil {
+		return nil, err
+	}
+	return t.AddRows(rows)
+}
+
+// inferColumn infers the type of the column from the records and sets the typed values into the rows,
+// returns the zero value of the type. Blank values are left out of the inference and are set to the zero
+// value of the inferred type, columns with blank values only are of type string
+func inferColumn(records [][]string, rows [][]any, column int) any {
+	var values int
+	for _, record := range records {
+		if !isBlank(record[column]) {
+			values++
+		}
+	}
+
+	ints := make([]int, len(records))
+	for i, record := range records {
+		if isBlank(record[column]) {
+			continue
+		}
+		v, err := strconv.Atoi(record[column])
+		if err != nil {
+			ints = nil
+			break
+		}
+		ints[i] = v
+	}
+	if ints != nil && values > 0 {
+		for i, v := range ints {
+			rows[i][column] = v
+		}
+		return 0
+	}
+
+	floats := make([]float64, len(records))
+	for i, record := range records {
+		if isBlank(record[column]) {
+			continue
+		}
+		v, err := parseCSVFloat(record[column])
+		if err != nil {
+			floats = nil
+			break
+		}
+		floats[i] = v
+	}
+	if floats != nil && values > 0 {
+		for i, v := range floats {
+			rows[i][column] = v
+		}
+		return 0.0
+	}
+
+	for i, record := range records {
+		rows[i][column] = record[column]
+	}
+	return ""
+}
+
+// isBlank checks if the value of the CSV field is missing
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// parseCSVFloat parses the decimal number, hexadecimal numbers, infinities and NaN are not accepted
+// so text columns holding values like "Inf" or "NaN" are not inferred as numbers
+func parseCSVFloat(s string) (float64, error) {
+	if strings.ContainsAny(s, "xX") {
+		return 0, strconv.ErrSyntax
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, strconv.ErrSyntax
+	}
+	return v, nil
+}
+
+// WriteCSV writes the headers and the rows of the table as CSV, by default the rows that pass the filters
+// are written in the order they are shown in, with the raw values of the visible columns
+func (r *Table) WriteCSV(writer io.Writer, opts CSVOptions) error {
+	csvWriter := csv.NewWriter(writer)
+	if opts.Comma != 0 {
+		csvWriter.Comma = opts.Comma
+	}
+	columns := r.exportColumns(opts.AllColumns)
+	record := make([]string, len(columns))
+	if !opts.NoHeader {
+		for i, index := range columns {
+			record[i] = r.columns[index].Header
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, row := range r.exportRows(opts.Rows) {
+		for i, index := range columns {
+			record[i] = r.FormatValue(index, row.Row[index], opts.Values)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
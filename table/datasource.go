@@ -0,0 +1,220 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// DataSource provides the rows of the table instead of the table holding them, only the rows
+// in view are read from it when rendering, so it can be backed by very large data sets
+type DataSource interface {
+	// Len returns the number of rows in the current view of the data source
+	Len() int
+	// Row returns the cells of the row on the index in the current view of the data source,
+	// cells have to match the types of the table columns
+	Row(index int) []any
+}
+
+// SortableDataSource is a DataSource that sorts the rows itself, tables backed by
+// a data source that does not implement it can't be sorted
+type SortableDataSource interface {
+	DataSource
+	// Sort orders the view by the keys, first key has the highest priority, no keys restores the original order.
+	// Keys without comparator are compared using the column comparator if the column has one
+	Sort(keys []SortKey) error
+}
+
+// FilterableDataSource is a DataSource that filters the rows itself, tables backed by
+// a data source that does not implement it can't be filtered
+type FilterableDataSource interface {
+	DataSource
+	// Filter narrows the view to the rows matching the filters combined with the operator, no filters
+	// resets the filtering. Filters are already parsed for the column types, Filter.Match can be used
+	// to match the cells the same way the table does
+	Filter(filters []Filter, operator FilterOperator) error
+}
+
+// NewTableWithDataSource initialize Table object with the columns and the data source the rows are read from,
+// rows can't be added to the table, selected or edited, see DataSource
+func NewTableWithDataSource(width, height int, columns []Column, source DataSource) (*Table, error) {
+	columns, err := normalizeColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+	r := newTable(width, height, columns)
+	r.source = source
+	r.RefreshDataSource()
+	return r, nil
+}
+
+// GetDataSource returns the data source the table reads the rows from, nil if the table holds the rows
+func (r *Table) GetDataSource() DataSource {
+	return r.source
+}
+
+// RefreshDataSource re-reads the number of rows and the rows in view, it should be called
+// when the data behind the data source changes
+func (r *Table) RefreshDataSource() *Table {
+	if r.source == nil {
+		return r
+	}
+	r.sourceLen = r.source.Len()
+	r.sourceWindow = nil
+	r.relocateCursor(-1)
+	r.setHeadersUpdate()
+	return r
+}
+
+// sortSource pushes the sort keys down to the data source
+func (r *Table) sortSource(keys []SortKey) error {
+	source, ok := r.source.(SortableDataSource)
+	if !ok {
+		return ErrorDataSource{msg: "data source does not support sorting"}
+	}
+	if err := source.Sort(r.resolveComparators(keys)); err != nil {
+		return ErrorDataSource{msg: fmt.Sprintf("data source failed to sort: %s", err)}
+	}
+	r.sortKeys = append([]SortKey(nil), keys...)
+	r.RefreshDataSource()
+	return nil
+}
+
+// filterSource pushes the filters that are applied down to the data source
+func (r *Table) filterSource() error {
+	source, ok := r.source.(FilterableDataSource)
+	if !ok {
+		if len(r.filters) == 0 {
+			return nil
+		}
+		return ErrorDataSource{msg: "data source does not support filtering"}
+	}
+	var filters []Filter
+	for _, f := range r.filters {
+		// invalid filters are ignored until they are corrected
+		if f.err == nil {
+			filters = append(filters, f)
+		}
+	}
+	if err := source.Filter(filters, r.filterOperator); err != nil {
+		return ErrorDataSource{msg: fmt.Sprintf("data source failed to filter: %s", err)}
+	}
+	r.RefreshDataSource()
+	return nil
+}
+
+// rowCount returns the number of rows in view
+func (r *Table) rowCount() int {
+	if r.source != nil {
+		return r.sourceLen
+	}
+	return len(r.filteredRows)
+}
+
+// viewRow returns the row on the position in view, rows of the data source are read from the window
+// of the rendered rows when possible
+func (r *Table) viewRow(i int) []any {
+	if r.source == nil {
+		return r.filteredRows[i]
+	}
+	if i >= r.sourceWindowTop && i < r.sourceWindowTop+len(r.sourceWindow) {
+		return r.sourceWindow[i-r.sourceWindowTop]
+	}
+	return r.source.Row(i)
+}
+
+// visibleRows returns the rows in view from the top to the bottom position, only those rows
+// are read from the data source and kept until the window moves
+func (r *Table) visibleRows(top, bottom int) [][]any {
+	if r.source == nil {
+		return r.filteredRows[top:bottom]
+	}
+	window := make([][]any, 0, bottom-top)
+	for i := top; i < bottom; i++ {
+		window = append(window, r.viewRow(i))
+	}
+	r.sourceWindow, r.sourceWindowTop = window, top
+	return window
+}
+
+// MemoryDataSource is a DataSource holding the rows in memory, it supports sorting and filtering
+type MemoryDataSource struct {
+	rows [][]any
+	// order is the permutation of the rows in the sort order, view is the part of it that passes the filters
+	order []int
+	view  []int
+	// filters are kept so they can be re-applied after sorting
+	filters        []Filter
+	filterOperator FilterOperator
+}
+
+// NewMemoryDataSource initialize MemoryDataSource with the rows, rows are not copied
+func NewMemoryDataSource(rows [][]any) *MemoryDataSource {
+	m := &MemoryDataSource{rows: rows, order: sequence(0, len(rows))}
+	m.view = m.order
+	return m
+}
+
+// Len returns the number of rows that pass the filters
+func (m *MemoryDataSource) Len() int {
+	return len(m.view)
+}
+
+// Row returns the row on the index in the sorted and filtered view
+func (m *MemoryDataSource) Row(index int) []any {
+	return m.rows[m.view[index]]
+}
+
+// Sort orders the rows by the keys, sorting is stable so equal rows keep the order they were added in
+func (m *MemoryDataSource) Sort(keys []SortKey) error {
+	order := sequence(0, len(m.rows))
+	if len(keys) > 0 {
+		slices.SortStableFunc(order, func(a, b int) int {
+			return compareRows(m.rows[a], m.rows[b], keys)
+		})
+	}
+	m.order = order
+	return m.Filter(m.filters, m.filterOperator)
+}
+
+// Filter narrows the view to the rows matching the filters, fuzzy filters rank the rows by the match score
+func (m *MemoryDataSource) Filter(filters []Filter, operator FilterOperator) error {
+	m.filters, m.filterOperator = filters, operator
+	if len(filters) == 0 {
+		m.view = m.order
+		return nil
+	}
+	var view, scores []int
+	var ranked bool
+	for _, f := range filters {
+		ranked = ranked || f.Mode == FilterModeFuzzy
+	}
+	for _, index := range m.order {
+		var score int
+		matched := operator == FilterOperatorAnd
+		for _, f := range filters {
+			ok, s := f.Match(m.rows[index][f.Column])
+			score += s
+			if operator == FilterOperatorAnd {
+				matched = matched && ok
+			} else {
+				matched = matched || ok
+			}
+		}
+		if matched {
+			view = append(view, index)
+			scores = append(scores, score)
+		}
+	}
+	if ranked {
+		positions := sequence(0, len(view))
+		sort.SliceStable(positions, func(i, j int) bool { return scores[positions[i]] > scores[positions[j]] })
+		rankedView := make([]int, len(view))
+		for i, p := range positions {
+			rankedView[i] = view[p]
+		}
+		view = rankedView
+	}
+	m.view = view
+	return nil
+}
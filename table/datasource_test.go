@@ -0,0 +1,169 @@
+package table
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// slowSource stands in for a slow backend like a database, every row read is delayed and recorded,
+// sorting and filtering are recorded and pushed down to the in-memory data source it wraps
+type slowSource struct {
+	*MemoryDataSource
+	delay time.Duration
+	// reads holds the indexes of the rows read since the last reset
+	reads   []int
+	sorts   [][]SortKey
+	filters [][]Filter
+}
+
+// Row reads the row with a delay and records the read
+func (s *slowSource) Row(index int) []any {
+	time.Sleep(s.delay)
+	s.reads = append(s.reads, index)
+	return s.MemoryDataSource.Row(index)
+}
+
+// Sort records the keys and sorts the wrapped data source
+func (s *slowSource) Sort(keys []SortKey) error {
+	s.sorts = append(s.sorts, keys)
+	return s.MemoryDataSource.Sort(keys)
+}
+
+// Filter records the filters and filters the wrapped data source
+func (s *slowSource) Filter(filters []Filter, operator FilterOperator) error {
+	s.filters = append(s.filters, filters)
+	return s.MemoryDataSource.Filter(filters, operator)
+}
+
+// plainSource is a DataSource that supports neither sorting nor filtering
+type plainSource struct {
+	rows [][]any
+}
+
+func (p plainSource) Len() int            { return len(p.rows) }
+func (p plainSource) Row(index int) []any { return p.rows[index] }
+
+// dataSourceRows returns rows with increasing id and a name
+func dataSourceRows(n int) [][]any {
+	rows := make([][]any, n)
+	for i := range rows {
+		name := "odd"
+		if i%2 == 0 {
+			name = "even"
+		}
+		rows[i] = []any{i, name}
+	}
+	return rows
+}
+
+// newSlowSourceTable returns table backed by slowSource with n rows
+func newSlowSourceTable(t *testing.T, n int) (*Table, *slowSource) {
+	t.Helper()
+	source := &slowSource{MemoryDataSource: NewMemoryDataSource(dataSourceRows(n)), delay: time.Microsecond}
+	columns := []Column{{Header: "id", Type: 0}, {Header: "name", Type: ""}}
+	table, err := NewTableWithDataSource(80, 12, columns, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return table, source
+}
+
+// checkWindowReads checks that only the rows in view were read from the source
+func checkWindowReads(t *testing.T, table *Table, source *slowSource) {
+	t.Helper()
+	if len(source.reads) == 0 {
+		t.Fatal("no rows were read from the data source")
+	}
+	if len(source.reads) > table.rowsBoxHeight {
+		t.Errorf("read %d rows, expected at most %d rows in view", len(source.reads), table.rowsBoxHeight)
+	}
+	for _, index := range source.reads {
+		if index < table.rowsTopIndex || index >= table.rowsTopIndex+table.rowsBoxHeight {
+			t.Errorf("row %d read outside of the view %d..%d", index, table.rowsTopIndex, table.rowsTopIndex+table.rowsBoxHeight)
+		}
+	}
+}
+
+func TestDataSourceRenderReadsWindow(t *testing.T) {
+	table, source := newSlowSourceTable(t, 100_000)
+
+	table.Render()
+	checkWindowReads(t, table, source)
+
+	// moving the cursor inside the view does not read the rows again
+	source.reads = nil
+	table.CursorDown()
+	table.Render()
+	if len(source.reads) > 0 {
+		t.Errorf("read %d rows when the view did not move", len(source.reads))
+	}
+
+	source.reads = nil
+	table.CursorBottom()
+	table.Render()
+	if table.rowsTopIndex == 0 {
+		t.Fatal("view did not move to the bottom")
+	}
+	checkWindowReads(t, table, source)
+}
+
+func TestDataSourceSortPushdown(t *testing.T) {
+	table, source := newSlowSourceTable(t, 1_000)
+
+	if _, err := table.SetSort(SortKey{Column: 0, Order: SortingOrderDescending}); err != nil {
+		t.Fatal(err)
+	}
+	if len(source.sorts) != 1 || len(source.sorts[0]) != 1 || source.sorts[0][0].Column != 0 {
+		t.Fatalf("sort keys were not pushed down, got %v", source.sorts)
+	}
+	table.Render()
+	if value := table.GetCursorValue(); value != "999" {
+		t.Errorf("expected the highest id under the cursor, got %q", value)
+	}
+	if column, order := table.GetOrder(); column != 0 || order != SortingOrderDescending {
+		t.Errorf("expected descending order on column 0, got %d %d", column, order)
+	}
+}
+
+func TestDataSourceFilterPushdown(t *testing.T) {
+	table, source := newSlowSourceTable(t, 1_000)
+
+	if _, err := table.SetFilter(0, ">=990"); err != nil {
+		t.Fatal(err)
+	}
+	if len(source.filters) == 0 {
+		t.Fatal("filters were not pushed down")
+	}
+	filters := source.filters[len(source.filters)-1]
+	if len(filters) != 1 || filters[0].Column != 0 {
+		t.Fatalf("expected single filter on column 0, got %v", filters)
+	}
+	if table.rowCount() != 10 {
+		t.Errorf("expected 10 rows in view, got %d", table.rowCount())
+	}
+
+	table.ClearFilters()
+	if filters := source.filters[len(source.filters)-1]; len(filters) != 0 {
+		t.Errorf("expected filters to be cleared, got %v", filters)
+	}
+	if table.rowCount() != 1_000 {
+		t.Errorf("expected all rows in view, got %d", table.rowCount())
+	}
+}
+
+func TestDataSourceWithoutPushdown(t *testing.T) {
+	columns := []Column{{Header: "id", Type: 0}, {Header: "name", Type: ""}}
+	table, err := NewTableWithDataSource(80, 12, columns, plainSource{rows: dataSourceRows(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sourceErr ErrorDataSource
+	if _, err := table.SetSort(SortKey{Column: 0}); !errors.As(err, &sourceErr) {
+		t.Errorf("expected ErrorDataSource when sorting, got %v", err)
+	}
+	if _, err := table.SetFilter(0, "1"); !errors.As(err, &sourceErr) {
+		t.Errorf("expected ErrorDataSource when filtering, got %v", err)
+	}
+}
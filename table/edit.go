@@ -0,0 +1,135 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// EditMsg is sent to the parent model when the edit of the cell is committed
+type EditMsg struct {
+	Key    RowKey
+	Column int
+	// Old and New are the values of the cell before and after the edit
+	Old, New any
+}
+
+// EditCursor enters the edit mode on the cell under the cursor, the editor is rendered in place of the cell
+func (r *Table) EditCursor() (*Table, error) {
+	key, ok := r.GetCursorRowKey()
+	if !ok {
+		return r, ErrorRowNotFound{msg: "there is no row under the cursor to edit"}
+	}
+	editor := textinput.New()
+	editor.Prompt = ""
+	editor.Cursor.SetMode(cursor.CursorStatic)
+	editor.SetValue(r.GetCursorValue())
+	editor.Focus()
+
+	r.editor = editor
+	r.editing = true
+	r.editKey = key
+	r.editColumn = r.cursorIndexX
+	r.editErr = nil
+	r.setRowsUpdate()
+	return r, nil
+}
+
+// CommitEdit parses the editor value according to the column type and updates the cell,
+// if parsing fails the error is of ErrorBadCellType type and the edit mode is kept so the value can be corrected
+func (r *Table) CommitEdit() (*Table, error) {
+	if !r.editing {
+		return r, nil
+	}
+	if _, err := r.rowIndex(r.editKey); err != nil {
+		// row got removed while editing
+		r.CancelEdit()
+		return r, err
+	}
+	value, err := parseCellValue(r.editor.Value(), r.columns[r.editColumn].Type)
+	if err != nil {
+		r.editErr = err
+		r.setRowsUpdate()
+		return r, err
+	}
+	if _, err := r.UpdateCell(r.editKey, r.editColumn, value); err != nil {
+		r.editErr = err
+		r.setRowsUpdate()
+		return r, err
+	}
+	r.CancelEdit()
+	return r, nil
+}
+
+// CancelEdit leaves the edit mode discarding the editor value
+func (r *Table) CancelEdit() *Table {
+	r.editing = false
+	r.editErr = nil
+	r.editor.Blur()
+	r.setRowsUpdate()
+	return r
+}
+
+// IsEditing returns true if the table is in edit mode and is consuming the typed keys,
+// parent model should avoid acting on the printable keys while this is the case
+func (r *Table) IsEditing() bool {
+	return r.editing
+}
+
+// isEditedCell checks if the cell of the row with the index in rows and column is the one being edited
+func (r *Table) isEditedCell(rowIndex, columnIndex int) bool {
+	return r.editing && columnIndex == r.editColumn && r.rowKeys[rowIndex] == r.editKey
+}
+
+// renderEditor renders the editor fitted to the width of the cell
+func (r *Table) renderEditor(maxX int) string {
+	// leave a space for the cursor at the end of the value
+	r.editor.Width = maxX - 1
+	return r.editor.View()
+}
+
+// parseCellValue parses the string into the type of the column
+func parseCellValue(s string, columnType any) (any, error) {
+	t := reflect.TypeOf(columnType)
+	var value any
+	var err error
+	switch columnType := columnType.(type) {
+	case string:
+		return s, nil
+	case int, int8, int16, int32, int64:
+		value, err = strconv.ParseInt(s, 10, t.Bits())
+	case uint, uint8, uint16, uint32, uint64:
+		value, err = strconv.ParseUint(s, 10, t.Bits())
+	case float32, float64:
+		value, err = strconv.ParseFloat(s, t.Bits())
+	case bool:
+		value, err = strconv.ParseBool(s)
+	case time.Duration:
+		value, err = time.ParseDuration(s)
+	case time.Time:
+		value, err = parseTime(s)
+	case CellValueParser:
+		value, err = columnType.Parse(s)
+		if err == nil && reflect.TypeOf(value) != t {
+			return nil, ErrorBadType{msg: fmt.Sprintf(
+				"parser of type %s returned value of type %s", t.String(), reflect.TypeOf(value),
+			)}
+		}
+	default:
+		return nil, ErrorBadType{msg: fmt.Sprintf("editing is not supported for type %s", t.String())}
+	}
+	if err != nil {
+		reason := "not a valid value"
+		if errors.Is(err, strconv.ErrRange) {
+			reason = "out of range"
+		}
+		return nil, ErrorBadCellType{msg: fmt.Sprintf("%q is %s for type %s", s, reason, t.String())}
+	}
+	// convert parsed int64/uint64/float64 to the exact type of the column
+	return reflect.ValueOf(value).Convert(t).Interface(), nil
+}
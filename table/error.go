@@ -1,6 +1,6 @@
 package table
 
-// ErrorBadType type does not match Ordered interface types
+// ErrorBadType type is not one of the supported cell types
 type ErrorBadType struct {
 	msg string
 }
@@ -26,3 +26,57 @@ type ErrorBadCellType struct {
 func (e ErrorBadCellType) Error() string {
 	return e.msg
 }
+
+// ErrorBadFilter filter expression could not be parsed for the type of the column
+type ErrorBadFilter struct {
+	msg string
+}
+
+func (e ErrorBadFilter) Error() string {
+	return e.msg
+}
+
+// ErrorBadSortKey sort key column is out of range or used more than once
+type ErrorBadSortKey struct {
+	msg string
+}
+
+func (e ErrorBadSortKey) Error() string {
+	return e.msg
+}
+
+// ErrorRowNotFound there is no row with the given key
+type ErrorRowNotFound struct {
+	msg string
+}
+
+func (e ErrorRowNotFound) Error() string {
+	return e.msg
+}
+
+// ErrorBadColumnIndex column index is out of range of the table columns
+type ErrorBadColumnIndex struct {
+	msg string
+}
+
+func (e ErrorBadColumnIndex) Error() string {
+	return e.msg
+}
+
+// ErrorBadColumn column definition is not valid
+type ErrorBadColumn struct {
+	msg string
+}
+
+func (e ErrorBadColumn) Error() string {
+	return e.msg
+}
+
+// ErrorDataSource operation is not supported by the data source or the data source failed to do it
+type ErrorDataSource struct {
+	msg string
+}
+
+func (e ErrorDataSource) Error() string {
+	return e.msg
+}
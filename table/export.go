@@ -0,0 +1,243 @@
+package table
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// RowSet chooses which rows of the table are exported
+type RowSet int
+
+const (
+	// RowSetView exports the rows that pass the filters in the order they are shown in, this is the default
+	RowSetView RowSet = iota
+	// RowSetAll exports all the rows in the order they are sorted in, including the filtered out rows
+	RowSetAll
+	// RowSetSelected exports the selected rows in the order they are sorted in, including the filtered out rows
+	RowSetSelected
+)
+
+// exportRows returns the states of the rows in the set, indexed by their position in the export,
+// rows are not copied
+func (r *Table) exportRows(set RowSet) []RowState {
+	var rows []RowState
+	add := func(index int) {
+		key := r.rowKeys[index]
+		rows = append(rows, RowState{Key: key, Row: r.rows[index], Index: len(rows), Selected: r.IsRowSelected(key)})
+	}
+	switch {
+	case r.source != nil:
+		// data source holds only the rows of the view, and its rows can't be selected
+		if set == RowSetSelected {
+			return nil
+		}
+		for i := range r.rowCount() {
+			rows = append(rows, RowState{Row: r.viewRow(i), Index: i})
+		}
+	case set == RowSetAll || set == RowSetSelected:
+		for i := range r.orderedRows() {
+			index := r.orderedRowIndex(i)
+			if set == RowSetAll || r.IsRowSelected(r.rowKeys[index]) {
+				add(index)
+			}
+		}
+	default:
+		for i := range r.filteredRows {
+			add(r.filteredRowIndex(i))
+		}
+	}
+	return rows
+}
+
+// exportColumns returns the indexes of the exported columns, visible columns in the order they are rendered in,
+// or all the columns in the order they were defined in
+func (r *Table) exportColumns(all bool) []int {
+	if all {
+		return sequence(0, len(r.columns))
+	}
+	var columns []int
+	for _, index := range r.columnOrder {
+		if !r.columns[index].Hidden {
+			columns = append(columns, index)
+		}
+	}
+	return columns
+}
+
+// WriteMarkdown writes the rows that pass the filters in the order they are shown in, with the visible
+// columns, as GitHub flavored Markdown table, cells are formatted and columns aligned the same as in the table
+func (r *Table) WriteMarkdown(writer io.Writer) error {
+	columns := r.exportColumns(false)
+	cells := make([]string, len(columns))
+	var b strings.Builder
+	writeRow := func() {
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	for i, index := range columns {
+		cells[i] = escapeMarkdown(r.columns[index].Header)
+	}
+	writeRow()
+	for i, index := range columns {
+		switch r.columnAlignment(index) {
+		case AlignCenter:
+			cells[i] = ":---:"
+		case AlignRight:
+			cells[i] = "---:"
+		default:
+			cells[i] = "---"
+		}
+	}
+	writeRow()
+	for _, row := range r.exportRows(RowSetView) {
+		for i, index := range columns {
+			cells[i] = escapeMarkdown(r.formatCell(index, row.Row[index]))
+		}
+		writeRow()
+	}
+	_, err := io.WriteString(writer, b.String())
+	return err
+}
+
+// escapeMarkdown escapes the characters that would break the Markdown table cell
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// HTMLOptions configure writing the table with WriteHTML
+type HTMLOptions struct {
+	// DarkBackground picks the dark variants of the adaptive colors, the light variants are used by default
+	DarkBackground bool
+}
+
+// WriteHTML writes the rows that pass the filters in the order they are shown in, with the visible columns,
+// as standalone HTML table, header, row and cell styles are carried over as inline CSS, without the cursor
+func (r *Table) WriteHTML(writer io.Writer, opts HTMLOptions) error {
+	columns := r.exportColumns(false)
+	var b strings.Builder
+	b.WriteString(`<table style="border-collapse: collapse; font-family: monospace;">` + "\n")
+
+	b.WriteString("<thead>\n<tr>\n")
+	for _, index := range columns {
+		style := cssStyle(r.styles[StyleKeyHeader], r.columnAlignment(index), opts.DarkBackground)
+		b.WriteString(fmt.Sprintf("<th style=\"%s\">%s</th>\n", style, escapeHTML(r.columns[index].Header)))
+	}
+	b.WriteString("</tr>\n</thead>\n")
+
+	b.WriteString("<tbody>\n")
+	for _, state := range r.exportRows(RowSetView) {
+		rowStyle := r.rowStyle(state)
+		b.WriteString("<tr>\n")
+		for _, index := range columns {
+			value := state.Row[index]
+			cellStyle := rowStyle
+			if style, ok := r.cellStyle(CellState{RowState: state, Column: index, Value: value}, rowStyle); ok {
+				cellStyle = style
+			}
+			b.WriteString(fmt.Sprintf(
+				"<td style=\"%s\">%s</td>\n",
+				cssStyle(cellStyle, r.columnAlignment(index), opts.DarkBackground), escapeHTML(r.formatCell(index, value)),
+			))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	_, err := io.WriteString(writer, b.String())
+	return err
+}
+
+// escapeHTML escapes the text of the cell, line breaks are kept
+func escapeHTML(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+}
+
+// cssStyle returns the inline CSS of the colors and text attributes of the style
+func cssStyle(style lipgloss.Style, align Alignment, dark bool) string {
+	declarations := []string{"padding: 0 0.5em"}
+	switch align {
+	case AlignCenter:
+		declarations = append(declarations, "text-align: center")
+	case AlignRight:
+		declarations = append(declarations, "text-align: right")
+	default:
+		declarations = append(declarations, "text-align: left")
+	}
+	if color := cssColor(style.GetForeground(), dark); color != "" {
+		declarations = append(declarations, "color: "+color)
+	}
+	if color := cssColor(style.GetBackground(), dark); color != "" {
+		declarations = append(declarations, "background-color: "+color)
+	}
+	if style.GetBold() {
+		declarations = append(declarations, "font-weight: bold")
+	}
+	if style.GetItalic() {
+		declarations = append(declarations, "font-style: italic")
+	}
+	if style.GetFaint() {
+		declarations = append(declarations, "opacity: 0.6")
+	}
+	var decorations []string
+	if style.GetUnderline() {
+		decorations = append(decorations, "underline")
+	}
+	if style.GetStrikethrough() {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		declarations = append(declarations, "text-decoration: "+strings.Join(decorations, " "))
+	}
+	return strings.Join(declarations, "; ")
+}
+
+// cssColor returns the hex value of the terminal color, empty string when there is no color,
+// ANSI colors are converted using the standard palette and adaptive colors are picked by the background
+func cssColor(color lipgloss.TerminalColor, dark bool) string {
+	switch c := color.(type) {
+	case lipgloss.Color:
+		if strings.HasPrefix(string(c), "#") {
+			return string(c)
+		}
+		if n, err := strconv.Atoi(string(c)); err == nil && n >= 0 && n < 256 {
+			return termenv.ConvertToRGB(termenv.ANSI256Color(n)).Hex()
+		}
+		return ""
+	case lipgloss.ANSIColor:
+		return cssColor(lipgloss.Color(strconv.Itoa(int(c))), dark)
+	case lipgloss.AdaptiveColor:
+		if dark {
+			return cssColor(lipgloss.Color(c.Dark), dark)
+		}
+		return cssColor(lipgloss.Color(c.Light), dark)
+	case lipgloss.CompleteColor:
+		return cssColor(lipgloss.Color(c.TrueColor), dark)
+	case lipgloss.CompleteAdaptiveColor:
+		if dark {
+			return cssColor(lipgloss.Color(c.Dark.TrueColor), dark)
+		}
+		return cssColor(lipgloss.Color(c.Light.TrueColor), dark)
+	default:
+		return ""
+	}
+}
+
+// WriteText writes the rows that pass the filters in the order they are shown in, with the visible
+// columns, as fixed-width plain text, see RenderStatic
+func (r *Table) WriteText(writer io.Writer) error {
+	lines := strings.Split(r.RenderStatic(StaticOptions{ASCII: true}), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	_, err := io.WriteString(writer, strings.Join(lines, "\n")+"\n")
+	return err
+}
@@ -0,0 +1,193 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// expressionOperator is the operation filter expression performs on the cell value
+type expressionOperator int
+
+const (
+	expressionContains expressionOperator = iota
+	expressionEqual
+	expressionNotEqual
+	expressionGreater
+	expressionGreaterEqual
+	expressionLess
+	expressionLessEqual
+	expressionRange
+	expressionPrefix
+	expressionSuffix
+)
+
+// filterExpression is a parsed filter string, values are normalized using normalizeOrdered
+// so they can be compared against the cells of the column the expression was parsed for
+//
+// supported syntax, where x and y are parsed according to the column type:
+//
+//	x       contains x for string columns, equals x for numeric columns
+//	=x !=x  equal, not equal
+//	>x >=x  greater, greater or equal
+//	<x <=x  less, less or equal
+//	x..y    inclusive range, either side can be omitted
+//	^x x$   prefix, suffix, matched on the string value of the cell
+//	!expr   negates any of the above
+type filterExpression struct {
+	operator expressionOperator
+	negate   bool
+	// value is the operand of the operator, for range it's the lower bound
+	value any
+	// upper is the upper bound of the range
+	upper any
+}
+
+// parseFilterExpression parses the filter string for a column of the given type
+func parseFilterExpression(s string, columnType any) (filterExpression, error) {
+	var e filterExpression
+	s = strings.TrimSpace(s)
+
+	// != is an operator on its own, ! on its own negates the rest of the expression
+	if strings.HasPrefix(s, "!") && !strings.HasPrefix(s, "!=") {
+		e.negate = true
+		s = strings.TrimSpace(s[1:])
+	}
+	if s == "" {
+		return e, ErrorBadFilter{msg: "filter expression is empty"}
+	}
+
+	var err error
+	for _, prefix := range []struct {
+		operator expressionOperator
+		symbol   string
+	}{
+		// longer symbols have to be checked first
+		{expressionNotEqual, "!="},
+		{expressionGreaterEqual, ">="},
+		{expressionLessEqual, "<="},
+		{expressionGreater, ">"},
+		{expressionLess, "<"},
+		{expressionEqual, "="},
+	} {
+		if strings.HasPrefix(s, prefix.symbol) {
+			e.operator = prefix.operator
+			e.value, err = parseFilterValue(strings.TrimPrefix(s, prefix.symbol), columnType)
+			return e, err
+		}
+	}
+
+	if lower, upper, ok := strings.Cut(s, ".."); ok {
+		if lower == "" && upper == "" {
+			return e, ErrorBadFilter{msg: "range has no bounds"}
+		}
+		e.operator = expressionRange
+		if lower != "" {
+			if e.value, err = parseFilterValue(lower, columnType); err != nil {
+				return e, err
+			}
+		}
+		if upper != "" {
+			if e.upper, err = parseFilterValue(upper, columnType); err != nil {
+				return e, err
+			}
+		}
+		if e.value != nil && e.upper != nil && compareOrdered(e.value, e.upper) > 0 {
+			return e, ErrorBadFilter{msg: fmt.Sprintf("range lower bound %q is greater than upper bound %q", lower, upper)}
+		}
+		return e, nil
+	}
+
+	// prefix and suffix are always matched on the string representation of the cell
+	hasPrefix, hasSuffix := strings.HasPrefix(s, "^"), len(s) > 1 && strings.HasSuffix(s, "$")
+	switch {
+	case hasPrefix && hasSuffix:
+		e.operator = expressionEqual
+		e.value, err = parseFilterValue(s[1:len(s)-1], columnType)
+		return e, err
+	case hasPrefix:
+		e.operator = expressionPrefix
+		e.value = strings.ToLower(s[1:])
+		return e, nil
+	case hasSuffix:
+		e.operator = expressionSuffix
+		e.value = strings.ToLower(s[:len(s)-1])
+		return e, nil
+	}
+
+	if isTextType(columnType) {
+		e.operator = expressionContains
+		e.value = strings.ToLower(s)
+		return e, nil
+	}
+	// for numeric columns partial matches make little sense, "2" should not match 12 or 200
+	e.operator = expressionEqual
+	e.value, err = parseFilterValue(s, columnType)
+	return e, err
+}
+
+// parseFilterValue parses the operand of the expression into the normalized column type
+func parseFilterValue(s string, columnType any) (any, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, ErrorBadFilter{msg: "filter expression is missing a value"}
+	}
+	if _, ok := columnType.(string); ok {
+		return strings.ToLower(s), nil
+	}
+	if _, ok := columnType.(CellValue); ok {
+		if _, ok := columnType.(CellValueParser); !ok {
+			return nil, ErrorBadFilter{msg: fmt.Sprintf("type %T can only be filtered as text", columnType)}
+		}
+	}
+	v, err := parseCellValue(s, columnType)
+	var badType ErrorBadType
+	if errors.As(err, &badType) {
+		return nil, ErrorBadFilter{msg: fmt.Sprintf("filtering is not supported for type %T", columnType)}
+	}
+	if err != nil {
+		return nil, ErrorBadFilter{msg: fmt.Sprintf("%q is not a valid %T", s, columnType)}
+	}
+	return normalizeOrdered(v), nil
+}
+
+// match checks if the cell matches the expression, expressions do not score the matches
+func (e filterExpression) match(cell any) (bool, int) {
+	return e.matchOperator(cell) != e.negate, 0
+}
+
+func (e filterExpression) matchOperator(cell any) bool {
+	switch e.operator {
+	case expressionContains:
+		return strings.Contains(strings.ToLower(getStringFromOrdered(cell)), e.value.(string))
+	case expressionPrefix:
+		return strings.HasPrefix(strings.ToLower(getStringFromOrdered(cell)), e.value.(string))
+	case expressionSuffix:
+		return strings.HasSuffix(strings.ToLower(getStringFromOrdered(cell)), e.value.(string))
+	}
+
+	value := normalizeOrdered(cell)
+	if s, ok := value.(string); ok {
+		// string comparison is case-insensitive same as contains
+		value = strings.ToLower(s)
+	}
+	switch e.operator {
+	case expressionEqual:
+		return compareOrdered(value, e.value) == 0
+	case expressionNotEqual:
+		return compareOrdered(value, e.value) != 0
+	case expressionGreater:
+		return compareOrdered(value, e.value) > 0
+	case expressionGreaterEqual:
+		return compareOrdered(value, e.value) >= 0
+	case expressionLess:
+		return compareOrdered(value, e.value) < 0
+	case expressionLessEqual:
+		return compareOrdered(value, e.value) <= 0
+	case expressionRange:
+		return (e.value == nil || compareOrdered(value, e.value) >= 0) &&
+			(e.upper == nil || compareOrdered(value, e.upper) <= 0)
+	default:
+		return false
+	}
+}
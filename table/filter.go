@@ -0,0 +1,419 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FilterOperator decides how the filters on multiple columns are combined
+type FilterOperator int
+
+const (
+	// FilterOperatorAnd row is visible only if it matches all the filters
+	FilterOperatorAnd FilterOperator = iota
+	// FilterOperatorOr row is visible if it matches any of the filters
+	FilterOperatorOr
+)
+
+// FilterMode decides how the filter value is matched against the cells
+type FilterMode int
+
+const (
+	// FilterModeExpression parses the value as a typed filter expression, see filterExpression for the syntax
+	FilterModeExpression FilterMode = iota
+	// FilterModeCaseSensitive matches cells containing the value, case-sensitive
+	FilterModeCaseSensitive
+	// FilterModeRegex matches cells against the value as a regular expression
+	FilterModeRegex
+	// FilterModeFuzzy matches cells containing the characters of the value in order,
+	// rows are ranked by the match score
+	FilterModeFuzzy
+)
+
+// Filter is a filter set on a single column, Value is matched against the cells depending on the Mode
+type Filter struct {
+	Column int
+	Value  string
+	Mode   FilterMode
+
+	matcher cellMatcher
+	err     error
+}
+
+// Err returns the error of parsing the filter value, filters with an error are not applied
+func (f Filter) Err() error {
+	return f.err
+}
+
+// Match matches the cell against the filter the same way the table does, score is used to rank
+// the rows matched by fuzzy filters, filters with an error match no cells
+func (f Filter) Match(cell any) (bool, int) {
+	if f.err != nil || f.matcher == nil {
+		return false, 0
+	}
+	return f.matcher.match(cell)
+}
+
+// UnsetFilter resets filtering on all the columns
+func (r *Table) UnsetFilter() *Table {
+	return r.ClearFilters()
+}
+
+// SetFilter sets filtering value on a column using the filter mode of the table,
+// filters on other columns are kept, setting an empty string removes the filter from the column
+func (r *Table) SetFilter(columnIndex int, s string) (*Table, error) {
+	return r.AddFilter(columnIndex, s)
+}
+
+// SetFilterWithMode sets filtering value on a column overriding the filter mode of the table
+func (r *Table) SetFilterWithMode(columnIndex int, s string, mode FilterMode) (*Table, error) {
+	return r.addFilter(columnIndex, s, mode)
+}
+
+// GetFilter returns string used for filtering, the column index and the mode of the filter that was set last,
+// if there are no filters column index is -1, use GetFilters to get all the filters
+func (r *Table) GetFilter() (columnIndex int, s string, mode FilterMode) {
+	if len(r.filters) == 0 {
+		return -1, "", r.filterMode
+	}
+	f := r.filters[len(r.filters)-1]
+	return f.Column, f.Value, f.Mode
+}
+
+// AddFilter adds the filter on a column using the filter mode of the table, if the column is already
+// filtered the filter is replaced, setting an empty string removes the filter from the column.
+// If the value is not valid for the column type or the mode ErrorBadFilter is returned,
+// the filter is kept so it can be corrected but it's not applied
+func (r *Table) AddFilter(columnIndex int, s string) (*Table, error) {
+	return r.addFilter(columnIndex, s, r.filterMode)
+}
+
+// SetFilterMode sets the filter mode of the table, it's applied to all the existing filters
+// and to filters added later without an explicit mode, defaults to FilterModeExpression
+func (r *Table) SetFilterMode(mode FilterMode) (*Table, error) {
+	r.filterMode = mode
+	var err error
+	for i, f := range r.filters {
+		f.Mode = mode
+		r.filters[i] = r.compileFilter(f)
+		if err == nil {
+			err = r.filters[i].err
+		}
+	}
+	r.setFiltersUpdate()
+	return r, err
+}
+
+// GetFilterMode returns the filter mode of the table
+func (r *Table) GetFilterMode() FilterMode {
+	return r.filterMode
+}
+
+func (r *Table) addFilter(columnIndex int, s string, mode FilterMode) (*Table, error) {
+	if columnIndex < 0 || columnIndex >= len(r.columns) {
+		return r, ErrorBadFilter{msg: fmt.Sprintf("column index %d out of range", columnIndex)}
+	}
+	if _, ok := r.source.(FilterableDataSource); r.source != nil && !ok {
+		return r, ErrorDataSource{msg: "data source does not support filtering"}
+	}
+	if s == "" {
+		r.RemoveFilter(columnIndex)
+		return r, r.sourceErr
+	}
+	f := r.compileFilter(Filter{Column: columnIndex, Value: s, Mode: mode})
+	if i := r.filterIndex(columnIndex); i > -1 {
+		r.filters[i] = f
+	} else {
+		r.filters = append(r.filters, f)
+	}
+	r.setFiltersUpdate()
+	if f.err != nil {
+		return r, f.err
+	}
+	return r, r.sourceErr
+}
+
+// RemoveFilter removes the filter from a column, if the column is not filtered nothing happens
+func (r *Table) RemoveFilter(columnIndex int) *Table {
+	if i := r.filterIndex(columnIndex); i > -1 {
+		r.filters = append(r.filters[:i], r.filters[i+1:]...)
+		r.setFiltersUpdate()
+	}
+	return r
+}
+
+// ClearFilters removes filters from all the columns
+func (r *Table) ClearFilters() *Table {
+	r.filters = nil
+	r.setFiltersUpdate()
+	return r
+}
+
+// GetFilters returns a copy of all the active filters in the order they were added
+func (r *Table) GetFilters() []Filter {
+	filters := make([]Filter, len(r.filters))
+	copy(filters, r.filters)
+	return filters
+}
+
+// GetColumnFilter returns the filter string set on a column, and if the column is filtered at all
+func (r *Table) GetColumnFilter(columnIndex int) (string, bool) {
+	if i := r.filterIndex(columnIndex); i > -1 {
+		return r.filters[i].Value, true
+	}
+	return "", false
+}
+
+// SetFilterOperator sets how filters on multiple columns are combined, defaults to FilterOperatorAnd
+func (r *Table) SetFilterOperator(operator FilterOperator) *Table {
+	r.filterOperator = operator
+	r.setFiltersUpdate()
+	return r
+}
+
+// GetFilterOperator returns how filters on multiple columns are combined
+func (r *Table) GetFilterOperator() FilterOperator {
+	return r.filterOperator
+}
+
+// SetFilterValueMode sets whether text filters match the raw cell values or the values rendered
+// by the column formatters, expressions on non string columns always compare the raw values
+func (r *Table) SetFilterValueMode(mode ValueMode) *Table {
+	r.filterValueMode = mode
+	r.setFiltersUpdate()
+	return r
+}
+
+// GetFilterValueMode returns whether text filters match the raw or the formatted cell values
+func (r *Table) GetFilterValueMode() ValueMode {
+	return r.filterValueMode
+}
+
+// filterIndex returns the index of the filter set on a column, -1 if the column is not filtered
+func (r *Table) filterIndex(columnIndex int) int {
+	for i, f := range r.filters {
+		if f.Column == columnIndex {
+			return i
+		}
+	}
+	return -1
+}
+
+// compileFilter creates the matcher for the filter mode and the type of the filtered column
+func (r *Table) compileFilter(f Filter) Filter {
+	f.matcher, f.err = newCellMatcher(f.Value, f.Mode, r.columns[f.Column].Type)
+	return f
+}
+
+// compileFilters re-parses all the filters, should be called when column types change
+func (r *Table) compileFilters() {
+	for i, f := range r.filters {
+		r.filters[i] = r.compileFilter(f)
+	}
+	r.setFiltersUpdate()
+}
+
+// setFiltersUpdate applies the filters and flags rows and headers for update after filters have changed
+func (r *Table) setFiltersUpdate() {
+	r.matchRows(0)
+	r.applyFilter()
+	r.setRowsUpdate()
+	r.setHeadersUpdate()
+}
+
+// rowMatch is the cached result of matching a row against the filters
+type rowMatch struct {
+	matched bool
+	score   int
+}
+
+// matchRows matches the rows from the index onwards against the filters and caches the results,
+// rows before the index keep their cached results
+func (r *Table) matchRows(from int) {
+	r.rowMatches = r.rowMatches[:from]
+	for _, row := range r.rows[from:] {
+		matched, score := r.matchFilters(row)
+		r.rowMatches = append(r.rowMatches, rowMatch{matched: matched, score: score})
+	}
+}
+
+// applyFilter builds the filtered rows from the sorted rows and the cached filter results, it should be
+// called whenever filters, sorting or rows change. If any of the fuzzy filters is applied rows are ranked
+// by the match score, ties keep the current order. Cursor is kept on the same row, if the row is
+// filtered out the cursor falls back to the nearest visible row
+func (r *Table) applyFilter() *Table {
+	return r.applyFilterWithCursor(r.cursorRowIndex())
+}
+
+// applyFilterWithCursor is applyFilter that keeps the cursor on the row with the index in rows,
+// used when indexes of the rows change and current filtered rows can not be used to find the cursor row
+func (r *Table) applyFilterWithCursor(cursorRow int) *Table {
+	if r.source != nil {
+		// rows are filtered by the data source, it keeps the error to be returned by addFilter
+		r.sourceErr = r.filterSource()
+		return r
+	}
+	// cursorY ends up as the position of the cursor row in the new view,
+	// or the position of the next visible row if the cursor row is filtered out
+	cursorY := -1
+
+	// no filters should reset the filtering
+	if len(r.filters) == 0 {
+		r.filteredRows = r.orderedRows()
+		r.filteredIndex = r.sortIndex
+		if cursorRow > -1 {
+			cursorY = r.filteredPosition(cursorRow)
+		}
+		r.relocateCursor(cursorY)
+		return r
+	}
+
+	var filteredRows [][]any
+	var filteredIndex, scores []int
+	for i, row := range r.orderedRows() {
+		rowIndex := r.orderedRowIndex(i)
+		if rowIndex == cursorRow {
+			cursorY = len(filteredRows)
+		}
+		if m := r.rowMatches[rowIndex]; m.matched {
+			filteredRows = append(filteredRows, row)
+			filteredIndex = append(filteredIndex, rowIndex)
+			scores = append(scores, m.score)
+		}
+	}
+	if r.isRanked() {
+		index := sequence(0, len(filteredRows))
+		sort.SliceStable(index, func(i, j int) bool { return scores[index[i]] > scores[index[j]] })
+		rankedRows := make([][]any, len(filteredRows))
+		rankedIndex := make([]int, len(filteredRows))
+		for i, ri := range index {
+			rankedRows[i] = filteredRows[ri]
+			rankedIndex[i] = filteredIndex[ri]
+		}
+		filteredRows, filteredIndex = rankedRows, rankedIndex
+	}
+	r.filteredRows = filteredRows
+	r.filteredIndex = filteredIndex
+	if r.isRanked() && cursorRow > -1 {
+		// ranking breaks the relation to the sorted order, so nearest row is kept only if the row is not found
+		if y := r.filteredPosition(cursorRow); y > -1 {
+			cursorY = y
+		}
+	}
+	r.relocateCursor(cursorY)
+	r.setHeadersUpdate()
+	return r
+}
+
+// relocateCursor moves the cursor to the row position y in the filtered rows, and scrolls it into view
+// if y is -1 cursor position is kept as is, it's clamped to the filtered rows either way
+func (r *Table) relocateCursor(y int) {
+	if y > -1 {
+		if y != r.cursorIndexY {
+			r.cursorDirection = r.cursorDirection.setDown()
+			if y < r.cursorIndexY {
+				r.cursorDirection = r.cursorDirection.setUp()
+			}
+		}
+		r.cursorIndexY = y
+	}
+	if r.cursorIndexY >= r.rowCount() {
+		r.cursorIndexY = r.rowCount() - 1
+	}
+	if r.cursorIndexY < 0 {
+		r.cursorIndexY = 0
+	}
+	r.setTopRow()
+	r.setRowsUpdate()
+}
+
+// cursorRowIndex returns the index in rows of the row under the cursor, -1 if there is no such row
+func (r *Table) cursorRowIndex() int {
+	// rows of the data source are not held by the table
+	if r.source != nil || r.cursorIndexY < 0 || r.cursorIndexY >= len(r.filteredRows) {
+		return -1
+	}
+	return r.filteredRowIndex(r.cursorIndexY)
+}
+
+// filteredRowIndex returns the index in rows of the row on position i in the filtered rows
+func (r *Table) filteredRowIndex(i int) int {
+	if r.filteredIndex == nil {
+		return i
+	}
+	return r.filteredIndex[i]
+}
+
+// filteredPosition returns the position in the filtered rows of the row with index in rows, -1 if it's not visible
+func (r *Table) filteredPosition(rowIndex int) int {
+	if r.filteredIndex == nil {
+		if rowIndex < len(r.filteredRows) {
+			return rowIndex
+		}
+		return -1
+	}
+	for i, ri := range r.filteredIndex {
+		if ri == rowIndex {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchFilters checks the row against all the filters combining them with the filter operator,
+// score is the sum of the scores of the matched filters
+func (r *Table) matchFilters(row []any) (bool, int) {
+	var score int
+	var anyMatched bool
+	for _, f := range r.filters {
+		// invalid filters are ignored until they are corrected
+		if f.err != nil {
+			continue
+		}
+		cell := row[f.Column]
+		if r.filterValueMode == ValueFormatted && r.matchesText(f) {
+			cell = r.formatCell(f.Column, cell)
+		}
+		matched, s := f.matcher.match(cell)
+		if matched {
+			anyMatched = true
+			score += s
+		} else if r.filterOperator == FilterOperatorAnd {
+			return false, 0
+		}
+	}
+	if r.filterOperator == FilterOperatorOr {
+		return anyMatched || !r.hasValidFilters(), score
+	}
+	return true, score
+}
+
+// matchesText checks if the filter matches the cells as text, expressions on non string columns
+// compare typed values so they can't be matched against the formatted cells
+func (r *Table) matchesText(f Filter) bool {
+	if f.Mode != FilterModeExpression {
+		return true
+	}
+	_, ok := r.columns[f.Column].Type.(string)
+	return ok
+}
+
+// hasValidFilters checks if there is at least one filter that is applied
+func (r *Table) hasValidFilters() bool {
+	for _, f := range r.filters {
+		if f.err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// isRanked checks if the filtered rows should be ranked by the match score
+func (r *Table) isRanked() bool {
+	for _, f := range r.filters {
+		if f.err == nil && f.Mode == FilterModeFuzzy {
+			return true
+		}
+	}
+	return false
+}
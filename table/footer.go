@@ -0,0 +1,107 @@
+package table
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FooterState is the state of the table passed to the FooterGenerator
+type FooterState struct {
+	// CursorX and CursorY are the cursor location, CursorY is the index in the filtered rows
+	CursorX, CursorY int
+	// Rows is the number of rows that pass the filter and TotalRows is the number of all the rows
+	Rows, TotalRows int
+	// Selected is the number of selected rows, including the filtered out ones
+	Selected int
+	Filters  []Filter
+	// FilterOperator is the operator used to combine the filters
+	FilterOperator FilterOperator
+	SortKeys       []SortKey
+	// Width and Height are the dimensions of the rows box
+	Width, Height int
+	// EditErr is the error of the last failed edit commit, nil if there is none
+	EditErr error
+}
+
+// FooterGenerator renders the content of the footer from the table state, content is fitted into a single line
+type FooterGenerator func(state FooterState) string
+
+// DefaultFooter renders cursor location and rows box dimensions, prefixed by the filter of the cursor column
+// and the edit error if there is one
+func DefaultFooter(state FooterState) string {
+	statusMessage := fmt.Sprintf("%d:%d / %d:%d ", state.CursorX, state.CursorY, state.Width, state.Height)
+	for _, f := range state.Filters {
+		if f.Column != state.CursorX {
+			continue
+		}
+		if err := f.Err(); err != nil {
+			statusMessage = fmt.Sprintf("invalid filter %q: %s / %s", f.Value, err, statusMessage)
+		} else {
+			statusMessage = fmt.Sprintf("filtered by: %q / %s", f.Value, statusMessage)
+		}
+	}
+	if state.EditErr != nil {
+		statusMessage = fmt.Sprintf("invalid value: %s / %s", state.EditErr, statusMessage)
+	}
+	return statusMessage
+}
+
+// RowCountFooter renders the position of the cursor among the rows, e.g. "row 12 of 340 (filtered from 5,000)"
+func RowCountFooter(state FooterState) string {
+	if state.TotalRows == 0 {
+		return "no rows "
+	}
+	if state.Rows == 0 {
+		return fmt.Sprintf("no rows (filtered from %s) ", formatThousands(state.TotalRows))
+	}
+	statusMessage := fmt.Sprintf("row %s of %s", formatThousands(state.CursorY+1), formatThousands(state.Rows))
+	if state.Rows != state.TotalRows {
+		statusMessage += fmt.Sprintf(" (filtered from %s)", formatThousands(state.TotalRows))
+	}
+	if state.Selected > 0 {
+		statusMessage += fmt.Sprintf(", %s selected", formatThousands(state.Selected))
+	}
+	return statusMessage + " "
+}
+
+// SetFooterGenerator sets the generator of the footer content, nil restores DefaultFooter
+func (r *Table) SetFooterGenerator(generator FooterGenerator) *Table {
+	if generator == nil {
+		generator = DefaultFooter
+	}
+	r.footerGenerator = generator
+	return r
+}
+
+// GetFooterState returns the current state of the table as passed to the FooterGenerator
+func (r *Table) GetFooterState() FooterState {
+	return FooterState{
+		CursorX:        r.cursorIndexX,
+		CursorY:        r.cursorIndexY,
+		Rows:           r.rowCount(),
+		TotalRows:      max(len(r.rows), r.sourceLen),
+		Selected:       len(r.selected),
+		Filters:        r.GetFilters(),
+		FilterOperator: r.filterOperator,
+		SortKeys:       r.GetSort(),
+		Width:          r.rowsBox.GetWidth(),
+		Height:         r.rowsBox.GetHeight(),
+		EditErr:        r.editErr,
+	}
+}
+
+// renderFooter renders the footer content with the footer style, content is truncated to a single line
+// so the footer does not grow the table
+func (r *Table) renderFooter() string {
+	style := r.styles[StyleKeyFooter]
+	width := max(r.width-style.GetHorizontalFrameSize(), 0)
+	content := lipgloss.NewStyle().Inline(true).MaxWidth(width).Render(r.footerGenerator(r.GetFooterState()))
+	return style.Width(r.width).MaxHeight(1).Render(content)
+}
+
+// formatThousands formats the number with comma as the thousands separator
+func formatThousands(n int) string {
+	return groupThousands(strconv.Itoa(n))
+}
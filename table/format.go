@@ -0,0 +1,148 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ValueMode chooses between the raw values of the cells and the values rendered by the column formatter
+type ValueMode int
+
+const (
+	ValueRaw ValueMode = iota
+	ValueFormatted
+)
+
+// FormatFloat returns formatter that renders numbers with fixed number of decimals,
+// negative precision uses the smallest number of decimals needed to represent the value
+func FormatFloat(precision int) Formatter {
+	return func(value any) string {
+		n, ok := formatNumber(value, precision)
+		if !ok {
+			return getStringFromOrdered(value)
+		}
+		return n
+	}
+}
+
+// FormatThousands returns formatter that renders numbers with comma as the thousands separator
+// and fixed number of decimals, negative precision uses the smallest number of decimals needed
+func FormatThousands(precision int) Formatter {
+	return func(value any) string {
+		n, ok := formatNumber(value, precision)
+		if !ok {
+			return getStringFromOrdered(value)
+		}
+		return groupThousands(n)
+	}
+}
+
+// FormatPercent returns formatter that renders fractions as percentages, e.g. 0.256 as "25.6%" with precision 1
+func FormatPercent(precision int) Formatter {
+	return func(value any) string {
+		switch v := normalizeOrdered(value).(type) {
+		case int64, uint64:
+			// integers are multiplied in the text so they do not lose precision
+			n, _ := formatNumber(v, precision)
+			integer, fraction, _ := strings.Cut(n, ".")
+			if integer != "0" {
+				integer += "00"
+			}
+			if fraction != "" {
+				integer += "." + fraction
+			}
+			return integer + "%"
+		}
+		f, ok := numericValue(value)
+		if !ok {
+			return getStringFromOrdered(value)
+		}
+		return strconv.FormatFloat(f*100, 'f', precision, 64) + "%"
+	}
+}
+
+// FormatCurrency returns formatter that renders numbers as amounts with the symbol in front,
+// thousands separators and fixed number of decimals, e.g. -1234.5 as "-$1,234.50"
+func FormatCurrency(symbol string, precision int) Formatter {
+	return func(value any) string {
+		n, ok := formatNumber(value, precision)
+		if !ok {
+			return getStringFromOrdered(value)
+		}
+		s := groupThousands(n)
+		if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+			return s[:1] + symbol + s[1:]
+		}
+		return symbol + s
+	}
+}
+
+// FormatValue renders the value of the cell in the column with the column formatter,
+// or by its type if the column has no formatter or the mode is ValueRaw
+func (r *Table) FormatValue(columnIndex int, value any, mode ValueMode) string {
+	if mode == ValueRaw || columnIndex < 0 || columnIndex >= len(r.columns) {
+		return getStringFromOrdered(value)
+	}
+	return r.formatCell(columnIndex, value)
+}
+
+// numericValue returns the value as float64 if it's one of the numeric types
+func numericValue(value any) (float64, bool) {
+	switch v := normalizeOrdered(value).(type) {
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// formatNumber renders the value with fixed number of decimals if it's one of the numeric types, negative
+// precision uses the smallest number of decimals needed, integers are rendered without converting them
+// to float64 so large values keep all their digits
+func formatNumber(value any, precision int) (string, bool) {
+	var s string
+	switch v := normalizeOrdered(value).(type) {
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', precision, 64), true
+	default:
+		return "", false
+	}
+	if precision > 0 {
+		s += "." + strings.Repeat("0", precision)
+	}
+	return s, true
+}
+
+// isNumeric checks if the value is one of the numeric types
+func isNumeric(value any) bool {
+	_, ok := numericValue(value)
+	return ok
+}
+
+// groupThousands inserts comma as the thousands separator into the integer part of the formatted number,
+// values that are not finite numbers like "+Inf" and "NaN" are returned as they are
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i > -1 {
+		integer, fraction = s[:i], s[i:]
+	}
+	if strings.Trim(integer, "0123456789") != "" {
+		return sign + s
+	}
+	for i := len(integer) - 3; i > 0; i -= 3 {
+		integer = integer[:i] + "," + integer[i:]
+	}
+	return sign + integer + fraction
+}
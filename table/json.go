@@ -0,0 +1,407 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// RawJSON is the cell type of the columns that hold JSON arrays or values of mixed JSON types,
+// it's written back as JSON when exporting so the values keep their types
+type RawJSON string
+
+// String returns the compact JSON, JSON strings are returned without the quotes
+func (j RawJSON) String() string {
+	if strings.HasPrefix(string(j), `"`) {
+		var s string
+		if err := json.Unmarshal([]byte(j), &s); err == nil {
+			return s
+		}
+	}
+	return string(j)
+}
+
+// Compare compares the text returned by String
+func (j RawJSON) Compare(other CellValue) int {
+	return strings.Compare(j.String(), other.String())
+}
+
+// MarshalJSON returns the JSON as it is, empty value is written as null
+func (j RawJSON) MarshalJSON() ([]byte, error) {
+	if j == "" {
+		return []byte("null"), nil
+	}
+	return []byte(j), nil
+}
+
+// JSONOptions configure writing the table with WriteJSON and WriteNDJSON
+type JSONOptions struct {
+	// Rows chooses which rows are written
+	Rows RowSet
+	// AllColumns writes the hidden columns as well, in the order the columns were defined in
+	AllColumns bool
+	// Flat writes the headers as they are, by default headers with dotted paths are written as nested objects
+	Flat bool
+	// Indent is used to indent the JSON written by WriteJSON, it's not indented when empty
+	Indent string
+}
+
+// FromJSON initialize Table object from JSON array of objects, see FromNDJSON
+func FromJSON(reader io.Reader) (*Table, error) {
+	decoder := json.NewDecoder(reader)
+	if t, err := decoder.Token(); err != nil {
+		return nil, err
+	} else if t != json.Delim('[') {
+		return nil, ErrorBadType{msg: "json is not an array of objects"}
+	}
+	var records []json.RawMessage
+	for decoder.More() {
+		var record json.RawMessage
+		if err := decoder.Decode(&record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return fromJSONRecords(records)
+}
+
+// FromNDJSON initialize Table object from newline delimited JSON objects, nested objects are flattened
+// to columns with dotted paths e.g. "user.name", columns are the union of the keys of all the objects
+// in the order they first appear in, and their types are inferred from the values:
+//
+//	int, float64 and bool when all the values are of the type, int and float values make float64
+//	time.Time when all the values are RFC 3339 timestamps
+//	RawJSON when all the values are arrays, or the values are of mixed types so they keep their JSON types
+//	string for all the other columns
+//
+// missing and null values are set to the zero value of the column type, the keys are left out when
+// the rows are written with WriteJSON or WriteNDJSON unless the cells are updated
+func FromNDJSON(reader io.Reader) (*Table, error) {
+	decoder := json.NewDecoder(reader)
+	var records []json.RawMessage
+	for {
+		var record json.RawMessage
+		if err := decoder.Decode(&record); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return fromJSONRecords(records)
+}
+
+// fromJSONRecords flattens the records and creates the table from them
+func fromJSONRecords(records []json.RawMessage) (*Table, error) {
+	var headers []string
+	values := make(map[string][]any)
+	for i, record := range records {
+		if len(record) == 0 || record[0] != '{' {
+			return nil, ErrorBadType{msg: fmt.Sprintf("record on index %d is not an object", i)}
+		}
+		err := flattenJSON(record, "", func(key string, value any) {
+			if _, ok := values[key]; !ok {
+				headers = append(headers, key)
+				values[key] = make([]any, len(records))
+			}
+			values[key][i] = value
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(headers) == 0 {
+		return nil, ErrorBadColumn{msg: "json has no keys to read the columns from"}
+	}
+
+	columns := make([]Column, len(headers))
+	rows := make([][]any, len(records))
+	missing := make([]map[int]struct{}, len(records))
+	for i := range rows {
+		rows[i] = make([]any, len(headers))
+	}
+	for i, header := range headers {
+		columns[i] = Column{Header: header, Type: inferJSONColumn(values[header])}
+		for j, value := range values[header] {
+			rows[j][i] = jsonCellValue(value, columns[i].Type)
+			if value == nil {
+				if missing[j] == nil {
+					missing[j] = make(map[int]struct{})
+				}
+				missing[j][i] = struct{}{}
+			}
+		}
+	}
+
+	t, err := NewTableWithColumns(0, 0, columns)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.AddRows(rows); err != nil {
+		return nil, err
+	}
+	t.missing = make(map[RowKey]map[int]struct{})
+	for i, key := range t.rowKeys {
+		if missing[i] != nil {
+			t.missing[key] = missing[i]
+		}
+	}
+	return t, nil
+}
+
+// flattenJSON calls set for each of the values of the JSON object, keys of the nested objects are prefixed
+// with the key of the parent, values are json.Number, string, bool or RawJSON and null values are skipped
+func flattenJSON(object json.RawMessage, prefix string, set func(key string, value any)) error {
+	decoder := json.NewDecoder(bytes.NewReader(object))
+	decoder.UseNumber()
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key := prefix + token.(string)
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return err
+		}
+		switch raw[0] {
+		case '{':
+			if err := flattenJSON(raw, key+".", set); err != nil {
+				return err
+			}
+		case '[':
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, raw); err != nil {
+				return err
+			}
+			set(key, RawJSON(compact.String()))
+		case 'n':
+			continue
+		default:
+			var value any
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return err
+			}
+			if _, ok := value.(float64); ok {
+				value = json.Number(raw)
+			}
+			set(key, value)
+		}
+	}
+	return nil
+}
+
+// inferJSONColumn returns the zero value of the type all the values of the column can be converted to
+func inferJSONColumn(values []any) any {
+	var ints, floats, bools, strs, times, arrays, count int
+	for _, value := range values {
+		switch value := value.(type) {
+		case nil:
+			continue
+		case json.Number:
+			if _, err := value.Int64(); err == nil {
+				ints++
+			} else {
+				floats++
+			}
+		case bool:
+			bools++
+		case string:
+			strs++
+			if _, err := time.Parse(time.RFC3339, value); err == nil {
+				times++
+			}
+		case RawJSON:
+			arrays++
+		}
+		count++
+	}
+	switch {
+	case count == 0:
+		return ""
+	case ints == count:
+		return 0
+	case ints+floats == count:
+		return 0.0
+	case bools == count:
+		return false
+	case times == count:
+		return time.Time{}
+	case strs == count:
+		return ""
+	default:
+		// arrays or mixed types
+		return RawJSON("")
+	}
+}
+
+// jsonCellValue converts the flattened JSON value to the column type
+func jsonCellValue(value any, columnType any) any {
+	if value == nil {
+		return columnType
+	}
+	switch columnType.(type) {
+	case int:
+		v, _ := value.(json.Number).Int64()
+		return int(v)
+	case float64:
+		v, _ := value.(json.Number).Float64()
+		return v
+	case time.Time:
+		v, _ := time.Parse(time.RFC3339, value.(string))
+		return v
+	case bool:
+		return value
+	case RawJSON:
+		switch value := value.(type) {
+		case RawJSON:
+			return value
+		case json.Number:
+			return RawJSON(value)
+		default:
+			raw, _ := json.Marshal(value)
+			return RawJSON(raw)
+		}
+	default:
+		if s, ok := value.(string); ok {
+			return s
+		}
+		return fmt.Sprint(value)
+	}
+}
+
+// WriteJSON writes the rows of the table as JSON array of objects, by default the rows that pass
+// the filters are written in the order they are shown in, with the values of the visible columns
+func (r *Table) WriteJSON(writer io.Writer, opts JSONOptions) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", opts.Indent)
+	rows := r.exportRows(opts.Rows)
+	records := make([]*jsonObject, len(rows))
+	columns, paths := r.jsonColumns(opts)
+	for i, row := range rows {
+		records[i] = jsonRecord(row.Row, columns, paths, r.missing[row.Key])
+	}
+	return encoder.Encode(records)
+}
+
+// WriteNDJSON writes the rows of the table as newline delimited JSON objects, see WriteJSON
+func (r *Table) WriteNDJSON(writer io.Writer, opts JSONOptions) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	columns, paths := r.jsonColumns(opts)
+	for _, row := range r.exportRows(opts.Rows) {
+		if err := encoder.Encode(jsonRecord(row.Row, columns, paths, r.missing[row.Key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonColumns returns the exported columns and the path of the keys each of them is written to,
+// headers that have the header of another column as their prefix are not nested so the keys do not collide
+func (r *Table) jsonColumns(opts JSONOptions) ([]int, [][]string) {
+	columns := r.exportColumns(opts.AllColumns)
+	headers := make(map[string]bool, len(columns))
+	for _, index := range columns {
+		headers[r.columns[index].Header] = true
+	}
+	paths := make([][]string, len(columns))
+	for i, index := range columns {
+		header := r.columns[index].Header
+		paths[i] = []string{header}
+		if opts.Flat {
+			continue
+		}
+		path := strings.Split(header, ".")
+		for j := 1; j < len(path); j++ {
+			if headers[strings.Join(path[:j], ".")] {
+				path = nil
+				break
+			}
+		}
+		if path != nil {
+			paths[i] = path
+		}
+	}
+	return columns, paths
+}
+
+// jsonRecord returns the object holding the values of the columns of the row, columns that were missing
+// when the row was read are left out
+func jsonRecord(row []any, columns []int, paths [][]string, missing map[int]struct{}) *jsonObject {
+	record := &jsonObject{values: make(map[string]any)}
+	for i, index := range columns {
+		if _, ok := missing[index]; ok {
+			continue
+		}
+		value := row[index]
+		if v, ok := value.(CellValue); ok {
+			if _, ok := value.(json.Marshaler); !ok {
+				value = v.String()
+			}
+		}
+		record.set(paths[i], value)
+	}
+	return record
+}
+
+// jsonObject is JSON object that keeps the order of its keys
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// set sets the value at the path, nested objects are created as needed
+func (o *jsonObject) set(path []string, value any) {
+	key := path[0]
+	if len(path) == 1 {
+		if _, ok := o.values[key]; !ok {
+			o.keys = append(o.keys, key)
+		}
+		o.values[key] = value
+		return
+	}
+	child, ok := o.values[key].(*jsonObject)
+	if !ok {
+		child = &jsonObject{values: make(map[string]any)}
+		o.keys = append(o.keys, key)
+		o.values[key] = child
+	}
+	child.set(path[1:], value)
+}
+
+// MarshalJSON writes the keys in the order they were set in
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		// encoder keeps HTML characters unescaped, same as the encoder the object is written with
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(key); err != nil {
+			return nil, err
+		}
+		buffer.Truncate(buffer.Len() - 1)
+		buffer.WriteByte(':')
+		if err := encoder.Encode(o.values[key]); err != nil {
+			return nil, err
+		}
+		buffer.Truncate(buffer.Len() - 1)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
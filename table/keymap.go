@@ -0,0 +1,175 @@
+package table
+
+import "github.com/charmbracelet/bubbles/key"
+
+// KeyMap defines the key bindings used by the Table when it's used as a tea.Model,
+// it implements help.KeyMap so it can be passed to the help view as is
+type KeyMap struct {
+	CursorUp    key.Binding
+	CursorDown  key.Binding
+	CursorLeft  key.Binding
+	CursorRight key.Binding
+	PageUp      key.Binding
+	PageDown    key.Binding
+	Home        key.Binding
+	End         key.Binding
+
+	// Sort toggles between ascending and descending order on the column under the cursor
+	Sort key.Binding
+
+	// Filter enters the filter mode on the column under the cursor, while in filter mode
+	// keys typed are appended to the filter, AcceptFilter leaves the filter mode keeping the filter
+	// and ClearFilter removes the filter from the column whether filter mode is on or not
+	Filter       key.Binding
+	AcceptFilter key.Binding
+	ClearFilter  key.Binding
+
+	// Select emits SelectMsg with the value of the cell under the cursor
+	Select key.Binding
+
+	// ToggleSelect marks or unmarks the row under the cursor, SelectUp and SelectDown move the cursor
+	// extending the selected range, rest of the selection bindings act on the rows that pass the filter,
+	// all of them emit SelectionMsg
+	ToggleSelect    key.Binding
+	SelectUp        key.Binding
+	SelectDown      key.Binding
+	SelectAll       key.Binding
+	SelectNone      key.Binding
+	InvertSelection key.Binding
+
+	// HideColumn hides the column under the cursor and ShowColumns unhides all the columns,
+	// MoveColumnLeft and MoveColumnRight move the column under the cursor and RestoreColumnOrder
+	// moves the columns back to the order they were defined in
+	HideColumn         key.Binding
+	ShowColumns        key.Binding
+	MoveColumnLeft     key.Binding
+	MoveColumnRight    key.Binding
+	RestoreColumnOrder key.Binding
+	// AutoFitColumn fits the width of the column under the cursor to its content
+	AutoFitColumn key.Binding
+
+	// Edit enters the edit mode on the cell under the cursor, while in edit mode keys are sent
+	// to the cell editor, AcceptEdit commits the value and CancelEdit discards it
+	Edit       key.Binding
+	AcceptEdit key.Binding
+	CancelEdit key.Binding
+}
+
+// DefaultKeyMap returns the arrow based key bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		CursorUp:           key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "up")),
+		CursorDown:         key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "down")),
+		CursorLeft:         key.NewBinding(key.WithKeys("left"), key.WithHelp("←", "left")),
+		CursorRight:        key.NewBinding(key.WithKeys("right"), key.WithHelp("→", "right")),
+		PageUp:             key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up")),
+		PageDown:           key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdn", "page down")),
+		Home:               key.NewBinding(key.WithKeys("home"), key.WithHelp("home", "go to top")),
+		End:                key.NewBinding(key.WithKeys("end"), key.WithHelp("end", "go to bottom")),
+		Sort:               key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "sort")),
+		Filter:             key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		AcceptFilter:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "apply filter")),
+		ClearFilter:        key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter")),
+		Select:             key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		ToggleSelect:       key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "mark row")),
+		SelectUp:           key.NewBinding(key.WithKeys("shift+up"), key.WithHelp("shift+↑", "mark up")),
+		SelectDown:         key.NewBinding(key.WithKeys("shift+down"), key.WithHelp("shift+↓", "mark down")),
+		SelectAll:          key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "mark all")),
+		SelectNone:         key.NewBinding(key.WithKeys("ctrl+n"), key.WithHelp("ctrl+n", "unmark all")),
+		InvertSelection:    key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "invert marks")),
+		HideColumn:         key.NewBinding(key.WithKeys("-"), key.WithHelp("-", "hide column")),
+		ShowColumns:        key.NewBinding(key.WithKeys("+"), key.WithHelp("+", "show columns")),
+		MoveColumnLeft:     key.NewBinding(key.WithKeys("shift+left"), key.WithHelp("shift+←", "move column left")),
+		MoveColumnRight:    key.NewBinding(key.WithKeys("shift+right"), key.WithHelp("shift+→", "move column right")),
+		RestoreColumnOrder: key.NewBinding(key.WithKeys("ctrl+o"), key.WithHelp("ctrl+o", "restore columns")),
+		AutoFitColumn:      key.NewBinding(key.WithKeys("ctrl+w"), key.WithHelp("ctrl+w", "fit column")),
+		Edit:               key.NewBinding(key.WithKeys("e", "f2"), key.WithHelp("e", "edit")),
+		AcceptEdit:         key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+		CancelEdit:         key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	}
+}
+
+// VimKeyMap returns vim style key bindings, arrows are kept as well
+func VimKeyMap() KeyMap {
+	return KeyMap{
+		CursorUp:           key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k/↑", "up")),
+		CursorDown:         key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j/↓", "down")),
+		CursorLeft:         key.NewBinding(key.WithKeys("h", "left"), key.WithHelp("h/←", "left")),
+		CursorRight:        key.NewBinding(key.WithKeys("l", "right"), key.WithHelp("l/→", "right")),
+		PageUp:             key.NewBinding(key.WithKeys("ctrl+b", "pgup"), key.WithHelp("ctrl+b", "page up")),
+		PageDown:           key.NewBinding(key.WithKeys("ctrl+f", "pgdown"), key.WithHelp("ctrl+f", "page down")),
+		Home:               key.NewBinding(key.WithKeys("g", "home"), key.WithHelp("g", "go to top")),
+		End:                key.NewBinding(key.WithKeys("G", "end"), key.WithHelp("G", "go to bottom")),
+		Sort:               key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort")),
+		Filter:             key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		AcceptFilter:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "apply filter")),
+		ClearFilter:        key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter")),
+		Select:             key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		ToggleSelect:       key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "mark row")),
+		SelectUp:           key.NewBinding(key.WithKeys("K", "shift+up"), key.WithHelp("K", "mark up")),
+		SelectDown:         key.NewBinding(key.WithKeys("J", "shift+down"), key.WithHelp("J", "mark down")),
+		SelectAll:          key.NewBinding(key.WithKeys("V"), key.WithHelp("V", "mark all")),
+		SelectNone:         key.NewBinding(key.WithKeys("U"), key.WithHelp("U", "unmark all")),
+		InvertSelection:    key.NewBinding(key.WithKeys("~"), key.WithHelp("~", "invert marks")),
+		HideColumn:         key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "hide column")),
+		ShowColumns:        key.NewBinding(key.WithKeys("X"), key.WithHelp("X", "show columns")),
+		MoveColumnLeft:     key.NewBinding(key.WithKeys("H", "shift+left"), key.WithHelp("H", "move column left")),
+		MoveColumnRight:    key.NewBinding(key.WithKeys("L", "shift+right"), key.WithHelp("L", "move column right")),
+		RestoreColumnOrder: key.NewBinding(key.WithKeys("="), key.WithHelp("=", "restore columns")),
+		AutoFitColumn:      key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "fit column")),
+		Edit:               key.NewBinding(key.WithKeys("i", "f2"), key.WithHelp("i", "edit")),
+		AcceptEdit:         key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+		CancelEdit:         key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	}
+}
+
+// EmacsKeyMap returns emacs style key bindings, arrows are kept as well
+func EmacsKeyMap() KeyMap {
+	return KeyMap{
+		CursorUp:           key.NewBinding(key.WithKeys("ctrl+p", "up"), key.WithHelp("ctrl+p", "up")),
+		CursorDown:         key.NewBinding(key.WithKeys("ctrl+n", "down"), key.WithHelp("ctrl+n", "down")),
+		CursorLeft:         key.NewBinding(key.WithKeys("ctrl+b", "left"), key.WithHelp("ctrl+b", "left")),
+		CursorRight:        key.NewBinding(key.WithKeys("ctrl+f", "right"), key.WithHelp("ctrl+f", "right")),
+		PageUp:             key.NewBinding(key.WithKeys("alt+v", "pgup"), key.WithHelp("alt+v", "page up")),
+		PageDown:           key.NewBinding(key.WithKeys("ctrl+v", "pgdown"), key.WithHelp("ctrl+v", "page down")),
+		Home:               key.NewBinding(key.WithKeys("alt+<", "home"), key.WithHelp("alt+<", "go to top")),
+		End:                key.NewBinding(key.WithKeys("alt+>", "end"), key.WithHelp("alt+>", "go to bottom")),
+		Sort:               key.NewBinding(key.WithKeys("alt+s"), key.WithHelp("alt+s", "sort")),
+		Filter:             key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "filter")),
+		AcceptFilter:       key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "apply filter")),
+		ClearFilter:        key.NewBinding(key.WithKeys("ctrl+g"), key.WithHelp("ctrl+g", "clear filter")),
+		Select:             key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		ToggleSelect:       key.NewBinding(key.WithKeys("ctrl+@", " "), key.WithHelp("space", "mark row")),
+		SelectUp:           key.NewBinding(key.WithKeys("shift+up"), key.WithHelp("shift+↑", "mark up")),
+		SelectDown:         key.NewBinding(key.WithKeys("shift+down"), key.WithHelp("shift+↓", "mark down")),
+		SelectAll:          key.NewBinding(key.WithKeys("alt+a"), key.WithHelp("alt+a", "mark all")),
+		SelectNone:         key.NewBinding(key.WithKeys("alt+u"), key.WithHelp("alt+u", "unmark all")),
+		InvertSelection:    key.NewBinding(key.WithKeys("alt+i"), key.WithHelp("alt+i", "invert marks")),
+		HideColumn:         key.NewBinding(key.WithKeys("alt+k"), key.WithHelp("alt+k", "hide column")),
+		ShowColumns:        key.NewBinding(key.WithKeys("alt+y"), key.WithHelp("alt+y", "show columns")),
+		MoveColumnLeft:     key.NewBinding(key.WithKeys("alt+b", "shift+left"), key.WithHelp("alt+b", "move column left")),
+		MoveColumnRight:    key.NewBinding(key.WithKeys("alt+f", "shift+right"), key.WithHelp("alt+f", "move column right")),
+		RestoreColumnOrder: key.NewBinding(key.WithKeys("alt+o"), key.WithHelp("alt+o", "restore columns")),
+		AutoFitColumn:      key.NewBinding(key.WithKeys("alt+w"), key.WithHelp("alt+w", "fit column")),
+		Edit:               key.NewBinding(key.WithKeys("alt+e", "f2"), key.WithHelp("alt+e", "edit")),
+		AcceptEdit:         key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+		CancelEdit:         key.NewBinding(key.WithKeys("ctrl+g"), key.WithHelp("ctrl+g", "cancel")),
+	}
+}
+
+// ShortHelp implements help.KeyMap
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.CursorUp, k.CursorDown, k.Sort, k.Filter, k.Select}
+}
+
+// FullHelp implements help.KeyMap
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.CursorUp, k.CursorDown, k.CursorLeft, k.CursorRight},
+		{k.PageUp, k.PageDown, k.Home, k.End},
+		{k.Sort, k.Filter, k.AcceptFilter, k.ClearFilter},
+		{k.Select, k.Edit, k.AcceptEdit, k.CancelEdit},
+		{k.ToggleSelect, k.SelectUp, k.SelectDown, k.SelectAll, k.SelectNone, k.InvertSelection},
+		{k.HideColumn, k.ShowColumns, k.MoveColumnLeft, k.MoveColumnRight, k.RestoreColumnOrder, k.AutoFitColumn},
+	}
+}
@@ -0,0 +1,148 @@
+package table
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// fuzzy scoring values, loosely following the fzf scoring scheme
+const (
+	fuzzyScoreMatch        = 16
+	fuzzyScoreGapStart     = -3
+	fuzzyScoreGapExtension = -1
+	fuzzyBonusBoundary     = 8
+	fuzzyBonusConsecutive  = 4
+	// fuzzyBonusFirstCharMultiplier is applied to the boundary bonus of the first pattern character
+	fuzzyBonusFirstCharMultiplier = 2
+)
+
+// cellMatcher matches the cells of a column, score is used to rank the rows and is 0 for unranked matchers
+type cellMatcher interface {
+	match(cell any) (bool, int)
+}
+
+// newCellMatcher creates the matcher for the filter value depending on the mode and the column type
+func newCellMatcher(s string, mode FilterMode, columnType any) (cellMatcher, error) {
+	switch mode {
+	case FilterModeExpression:
+		return parseFilterExpression(s, columnType)
+	case FilterModeCaseSensitive:
+		return substringMatcher(s), nil
+	case FilterModeRegex:
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, ErrorBadFilter{msg: fmt.Sprintf("invalid regular expression: %s", err)}
+		}
+		return regexMatcher{re: re}, nil
+	case FilterModeFuzzy:
+		return fuzzyMatcher([]rune(strings.ToLower(s))), nil
+	default:
+		return nil, ErrorBadFilter{msg: fmt.Sprintf("unknown filter mode %d", mode)}
+	}
+}
+
+// substringMatcher matches cells containing the string, case-sensitive
+type substringMatcher string
+
+func (m substringMatcher) match(cell any) (bool, int) {
+	return strings.Contains(getStringFromOrdered(cell), string(m)), 0
+}
+
+// regexMatcher matches cells against the regular expression
+type regexMatcher struct {
+	re *regexp.Regexp
+}
+
+func (m regexMatcher) match(cell any) (bool, int) {
+	return m.re.MatchString(getStringFromOrdered(cell)), 0
+}
+
+// fuzzyMatcher matches cells containing all the pattern characters in order, case-insensitive
+type fuzzyMatcher []rune
+
+func (m fuzzyMatcher) match(cell any) (bool, int) {
+	return fuzzyScore(getStringFromOrdered(cell), m)
+}
+
+// fuzzyScore finds the shortest match of the lowercase pattern in s and scores it, first the pattern
+// is matched greedily left to right to find the end of the match, then right to left to find the start
+// matched characters on word boundaries and consecutive matches are rewarded, gaps are penalized
+func fuzzyScore(s string, pattern []rune) (bool, int) {
+	if len(pattern) == 0 {
+		return true, 0
+	}
+	original := []rune(s)
+	text := []rune(strings.ToLower(s))
+	if len(text) != len(original) {
+		// lowercasing changed the length, fall back to the lowered text for boundaries
+		original = text
+	}
+
+	pi, end := 0, -1
+	for i, c := range text {
+		if c == pattern[pi] {
+			pi++
+			if pi == len(pattern) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return false, 0
+	}
+	pi, start := len(pattern)-1, end
+	for i := end; i >= 0; i-- {
+		if text[i] == pattern[pi] {
+			pi--
+			if pi < 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	var score, consecutive int
+	inGap := false
+	pi = 0
+	for i := start; i <= end; i++ {
+		if pi < len(pattern) && text[i] == pattern[pi] {
+			score += fuzzyScoreMatch
+			if isFuzzyBoundary(original, i) {
+				if pi == 0 {
+					score += fuzzyBonusBoundary * fuzzyBonusFirstCharMultiplier
+				} else {
+					score += fuzzyBonusBoundary
+				}
+			}
+			score += consecutive * fuzzyBonusConsecutive
+			consecutive++
+			inGap = false
+			pi++
+			continue
+		}
+		if inGap {
+			score += fuzzyScoreGapExtension
+		} else {
+			score += fuzzyScoreGapStart
+		}
+		consecutive = 0
+		inGap = true
+	}
+	return true, score
+}
+
+// isFuzzyBoundary checks if the character on index i starts a word, either it's the first character,
+// it follows a non-alphanumeric character or it's a camel case transition
+func isFuzzyBoundary(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, c := text[i-1], text[i]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(c)
+}
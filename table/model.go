@@ -0,0 +1,234 @@
+package table
+
+import (
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SelectMsg is sent to the parent model when the cell under the cursor is selected
+type SelectMsg struct {
+	// X and Y are the cursor location of the selected cell
+	X, Y int
+	// Value is the raw value of the cell and Formatted is the value rendered by the column formatter
+	Value     string
+	Formatted string
+}
+
+// SortMsg is sent to the parent model when the ordering of the table changes
+type SortMsg struct {
+	Column int
+	Order  SortingOrderKey
+}
+
+// FilterMsg is sent to the parent model when the filter of the table changes,
+// empty Value indicates that the filtering was unset, Err is set if the filter expression is not valid
+type FilterMsg struct {
+	Column int
+	Value  string
+	Err    error
+}
+
+// Init implements tea.Model, table has no initial command
+func (r *Table) Init() tea.Cmd { return nil }
+
+// Update implements tea.Model, it handles the window resizing and key presses
+// events that parent model might care about are returned as commands
+func (r *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		r.SetWidth(msg.Width)
+		r.SetHeight(msg.Height)
+	case tea.KeyMsg:
+		return r, r.handleKey(msg)
+	}
+	return r, nil
+}
+
+// View implements tea.Model, it renders the table
+func (r *Table) View() string { return r.Render() }
+
+// SetKeyMap replaces the key bindings used by Update
+func (r *Table) SetKeyMap(keyMap KeyMap) *Table {
+	r.keyMap = keyMap
+	return r
+}
+
+// GetKeyMap returns the key bindings used by Update, can be passed to the help view
+func (r *Table) GetKeyMap() KeyMap {
+	return r.keyMap
+}
+
+// IsFiltering returns true if the table is in filter mode and is consuming the typed keys,
+// parent model should avoid acting on the printable keys while this is the case
+func (r *Table) IsFiltering() bool {
+	return r.filtering
+}
+
+// handleKey maps the key presses to the table actions
+func (r *Table) handleKey(msg tea.KeyMsg) tea.Cmd {
+	if r.editing {
+		return r.handleEditKey(msg)
+	}
+	if r.filtering {
+		return r.handleFilterKey(msg)
+	}
+	switch {
+	case key.Matches(msg, r.keyMap.CursorDown):
+		r.CursorDown()
+	case key.Matches(msg, r.keyMap.CursorUp):
+		r.CursorUp()
+	case key.Matches(msg, r.keyMap.CursorLeft):
+		r.CursorLeft()
+	case key.Matches(msg, r.keyMap.CursorRight):
+		r.CursorRight()
+	case key.Matches(msg, r.keyMap.PageDown):
+		r.CursorPageDown()
+	case key.Matches(msg, r.keyMap.PageUp):
+		r.CursorPageUp()
+	case key.Matches(msg, r.keyMap.Home):
+		r.CursorTop()
+	case key.Matches(msg, r.keyMap.End):
+		r.CursorBottom()
+	case key.Matches(msg, r.keyMap.Sort):
+		return r.toggleOrder()
+	case key.Matches(msg, r.keyMap.Filter):
+		r.filtering = true
+	case key.Matches(msg, r.keyMap.ClearFilter):
+		return r.clearFilter()
+	case key.Matches(msg, r.keyMap.Select):
+		return r.selectCursor()
+	case key.Matches(msg, r.keyMap.Edit):
+		_, _ = r.EditCursor()
+	case key.Matches(msg, r.keyMap.HideColumn):
+		_, _ = r.HideCursorColumn()
+	case key.Matches(msg, r.keyMap.ShowColumns):
+		r.ShowAllColumns()
+	case key.Matches(msg, r.keyMap.MoveColumnLeft):
+		r.MoveCursorColumnLeft()
+	case key.Matches(msg, r.keyMap.MoveColumnRight):
+		r.MoveCursorColumnRight()
+	case key.Matches(msg, r.keyMap.RestoreColumnOrder):
+		r.RestoreColumnOrder()
+	case key.Matches(msg, r.keyMap.AutoFitColumn):
+		r.AutoFitCursorColumn()
+	case key.Matches(msg, r.keyMap.ToggleSelect):
+		return r.selectionCmd(r.ToggleCursorSelection)
+	case key.Matches(msg, r.keyMap.SelectUp):
+		return r.selectionCmd(r.ExtendSelectionUp)
+	case key.Matches(msg, r.keyMap.SelectDown):
+		return r.selectionCmd(r.ExtendSelectionDown)
+	case key.Matches(msg, r.keyMap.SelectAll):
+		return r.selectionCmd(r.SelectAll)
+	case key.Matches(msg, r.keyMap.SelectNone):
+		return r.selectionCmd(r.SelectNone)
+	case key.Matches(msg, r.keyMap.InvertSelection):
+		return r.selectionCmd(r.InvertSelection)
+	}
+	return nil
+}
+
+// handleEditKey handles the key presses while in edit mode, successful edit emits EditMsg
+func (r *Table) handleEditKey(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, r.keyMap.AcceptEdit):
+		editKey, column := r.editKey, r.editColumn
+		old, _ := r.GetRow(editKey)
+		if _, err := r.CommitEdit(); err != nil {
+			// error is shown inline, edit mode is kept so the value can be corrected
+			return nil
+		}
+		row, _ := r.GetRow(editKey)
+		return msgCmd(EditMsg{Key: editKey, Column: column, Old: old[column], New: row[column]})
+	case key.Matches(msg, r.keyMap.CancelEdit):
+		r.CancelEdit()
+		return nil
+	}
+	var cmd tea.Cmd
+	r.editor, cmd = r.editor.Update(msg)
+	r.setRowsUpdate()
+	return cmd
+}
+
+// handleFilterKey handles the key presses while in filter mode
+func (r *Table) handleFilterKey(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, r.keyMap.AcceptFilter):
+		r.filtering = false
+	case key.Matches(msg, r.keyMap.ClearFilter):
+		return r.clearFilter()
+	case msg.Type == tea.KeyBackspace:
+		return r.filterWithKey("backspace")
+	case msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace:
+		var cmd tea.Cmd
+		for _, rn := range msg.Runes {
+			if unicode.IsPrint(rn) {
+				cmd = r.filterWithKey(string(rn))
+			}
+		}
+		return cmd
+	}
+	return nil
+}
+
+// clearFilter removes the filter from the column under the cursor and leaves the filter mode
+func (r *Table) clearFilter() tea.Cmd {
+	r.filtering = false
+	x, _ := r.GetCursorLocation()
+	if _, ok := r.GetColumnFilter(x); !ok {
+		return nil
+	}
+	r.RemoveFilter(x)
+	return msgCmd(FilterMsg{Column: x})
+}
+
+// toggleOrder sorts by the column under the cursor, toggling between asc and desc
+// sorting a new column always starts in ascending order
+func (r *Table) toggleOrder() tea.Cmd {
+	x, _ := r.GetCursorLocation()
+	column, order := r.GetOrder()
+	if column == x && order == SortingOrderAscending {
+		r.OrderByDesc(x)
+	} else {
+		r.OrderByAsc(x)
+	}
+	column, order = r.GetOrder()
+	return msgCmd(SortMsg{Column: column, Order: order})
+}
+
+// selectCursor emits the value of the cell under the cursor
+func (r *Table) selectCursor() tea.Cmd {
+	x, y := r.GetCursorLocation()
+	return msgCmd(SelectMsg{X: x, Y: y, Value: r.GetCursorValue(), Formatted: r.GetCursorFormattedValue()})
+}
+
+// selectionCmd applies the selection change and emits the resulting selection
+func (r *Table) selectionCmd(change func() *Table) tea.Cmd {
+	change()
+	return msgCmd(SelectionMsg{Keys: r.GetSelectedRowKeys()})
+}
+
+// filterWithKey updates the filter of the column under the cursor with the key pressed,
+// backspace removes the last character and removes the filter once it's empty
+func (r *Table) filterWithKey(key string) tea.Cmd {
+	x, _ := r.GetCursorLocation()
+	s, _ := r.GetColumnFilter(x)
+	if key == "backspace" {
+		if s == "" {
+			return nil
+		}
+		runes := []rune(s)
+		s = string(runes[:len(runes)-1])
+	} else {
+		s = s + key
+	}
+	// setting empty string removes the filter from the column
+	_, err := r.SetFilter(x, s)
+	return msgCmd(FilterMsg{Column: x, Value: s, Err: err})
+}
+
+// msgCmd wraps the message into a command
+func msgCmd(msg tea.Msg) tea.Cmd {
+	return func() tea.Msg { return msg }
+}
@@ -1,13 +1,17 @@
 package table
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
 	"strconv"
+	"time"
 )
 
 type Ordered interface {
-	int | int8 | int32 | int16 | int64 | float32 | float64 | string
+	int | int8 | int32 | int16 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string
 }
 
 type SortingOrderKey int
@@ -17,84 +21,429 @@ const (
 	SortingOrderDescending
 )
 
-// GetOrder returns the current order column index and phase
-func (r *Table) GetOrder() (int, SortingOrderKey) { return r.orderedColumnIndex, r.orderedColumnPhase }
+// SortKey is a single key of the multi-key sort, rows that are equal on the key are ordered by the next key
+type SortKey struct {
+	Column int
+	Order  SortingOrderKey
+	// Comparator compares the cells of the column, if nil the natural order of the column type is used
+	Comparator Comparator
+}
+
+// compare compares two cells using the key comparator and applies the key order
+func (k SortKey) compare(a, b any) int {
+	var c int
+	if k.Comparator != nil {
+		c = k.Comparator(a, b)
+	} else {
+		c = compareOrdered(a, b)
+	}
+	if k.Order == SortingOrderDescending {
+		return -c
+	}
+	return c
+}
+
+// GetOrder returns the column index and order of the primary sort key,
+// if rows are not sorted column index is -1
+func (r *Table) GetOrder() (int, SortingOrderKey) {
+	if len(r.sortKeys) == 0 {
+		return -1, SortingOrderDescending
+	}
+	return r.sortKeys[0].Column, r.sortKeys[0].Order
+}
 
 // OrderByAsc orders rows by a column with index n, in ascending order
 func (r *Table) OrderByAsc(index int) *Table {
-	// sanity check first, we won't return errors here, simply ignore if the user sends non-existing index
-	if index < len(r.columnHeaders) && len(r.filteredRows) > 1 {
-		r.orderedColumnPhase = SortingOrderAscending
-		r.rows = sortRows(r.rows, index, r.orderedColumnPhase)
-		r.setRowsUpdate()
-		r.orderedColumnIndex = index
-		r.setHeadersUpdate()
-	}
+	// we won't return errors here, simply ignore if the user sends non-existing index
+	_, _ = r.SetSort(SortKey{Column: index, Order: SortingOrderAscending})
 	return r
 }
 
 // OrderByDesc orders rows by a column with index n, in descending order
 func (r *Table) OrderByDesc(index int) *Table {
-	// sanity check first, we won't return errors here, simply ignore if the user sends non existing index
-	if index < len(r.columnHeaders) && len(r.filteredRows) > 1 {
-		r.orderedColumnPhase = SortingOrderDescending
-		r.rows = sortRows(r.rows, index, r.orderedColumnPhase)
+	// we won't return errors here, simply ignore if the user sends non-existing index
+	_, _ = r.SetSort(SortKey{Column: index, Order: SortingOrderDescending})
+	return r
+}
+
+// SetSort orders rows by multiple keys, first key has the highest priority, sorting is stable
+// so rows that are equal on all the keys keep the order they were added in. Sending no keys
+// restores the order rows were added in and removes the sort indicators from the header
+func (r *Table) SetSort(keys ...SortKey) (*Table, error) {
+	seen := make(map[int]bool, len(keys))
+	for _, k := range keys {
+		if k.Column < 0 || k.Column >= len(r.columns) {
+			return r, ErrorBadSortKey{msg: fmt.Sprintf("sort column index %d out of range", k.Column)}
+		}
+		if seen[k.Column] {
+			return r, ErrorBadSortKey{msg: fmt.Sprintf("column %d used in multiple sort keys", k.Column)}
+		}
+		seen[k.Column] = true
+	}
+	if r.source != nil {
+		if err := r.sortSource(keys); err != nil {
+			return r, err
+		}
 		r.setRowsUpdate()
-		r.orderedColumnIndex = index
 		r.setHeadersUpdate()
+		return r, nil
 	}
+	r.sortKeys = append([]SortKey(nil), keys...)
+	r.sort()
+	r.applyFilter()
+	r.setRowsUpdate()
+	r.setHeadersUpdate()
+	return r, nil
+}
+
+// AddSortKey appends the key to the current sort keys with the lowest priority,
+// if the column is already sorted its key is replaced keeping its priority
+func (r *Table) AddSortKey(key SortKey) (*Table, error) {
+	keys := r.GetSort()
+	if i := r.sortKeyIndex(key.Column); i > -1 {
+		keys[i] = key
+	} else {
+		keys = append(keys, key)
+	}
+	return r.SetSort(keys...)
+}
+
+// GetSort returns a copy of the current sort keys, ordered by priority
+func (r *Table) GetSort() []SortKey {
+	return append([]SortKey(nil), r.sortKeys...)
+}
+
+// ClearSort removes the sort keys, rows are shown in the order they were added in
+func (r *Table) ClearSort() *Table {
+	_, _ = r.SetSort()
 	return r
 }
 
-// updateOrderedVars updates bits and pieces revolving around ordering
-// toggling between asc and desc
-// updating ordering vars on TableOrdered
-func (r *Table) updateOrderedVars(index int) {
-	// toggle between ascending and descending and set default first sort to ascending
-	if r.orderedColumnIndex == index {
-		switch r.orderedColumnPhase {
-		case SortingOrderAscending:
-			r.orderedColumnPhase = SortingOrderDescending
+// sortKeyIndex returns the priority index of the column sort key, -1 if the column is not sorted
+func (r *Table) sortKeyIndex(columnIndex int) int {
+	for i, k := range r.sortKeys {
+		if k.Column == columnIndex {
+			return i
+		}
+	}
+	return -1
+}
 
-		case SortingOrderDescending:
-			r.orderedColumnPhase = SortingOrderAscending
+// sort computes the sort permutation of the rows for the current sort keys
+func (r *Table) sort() {
+	if len(r.sortKeys) == 0 {
+		r.sortIndex = nil
+		r.sortedRows = nil
+		return
+	}
+	r.sortIndex = r.sortIndexByKeys(r.sortKeys)
+	r.updateSortedRows()
+}
+
+// sortIndexByKeys returns stably sorted permutation of the rows indexes, single key sorts using the
+// natural column order are served from the per column cache so toggling asc/desc is O(n)
+func (r *Table) sortIndexByKeys(keys []SortKey) []int {
+	keys = r.resolveComparators(keys)
+	if len(keys) == 1 && keys[0].Comparator == nil {
+		column := keys[0].Column
+		ascending := r.columnSortIndex(column)
+		if keys[0].Order == SortingOrderAscending {
+			return slices.Clone(ascending)
 		}
-	} else {
-		r.orderedColumnPhase = SortingOrderDescending
+		return reverseStable(ascending, columnEqual(r.rows, column))
 	}
-	r.orderedColumnIndex = index
+	index := sequence(0, len(r.rows))
+	slices.SortFunc(index, r.rowsIndexComparator(keys))
+	return index
+}
 
-	r.setHeadersUpdate()
+// columnSortIndex returns ascending permutation of the rows by the column, it's cached until rows are cleared
+func (r *Table) columnSortIndex(column int) []int {
+	if index, ok := r.sortCache[column]; ok {
+		return index
+	}
+	index := sortIndexByColumn(r.rows, column)
+	r.sortCache[column] = index
+	return index
+}
+
+// sortIndexByColumn returns ascending permutation of the rows by the column, values are extracted into
+// a slice of the normalized type first so the comparisons avoid the type switches
+func sortIndexByColumn(rows [][]any, column int) []int {
+	if len(rows) == 0 {
+		return []int{}
+	}
+	switch normalizeOrdered(rows[0][column]).(type) {
+	case string:
+		return sortIndexOrdered(columnValues[string](rows, column))
+	case int64:
+		return sortIndexOrdered(columnValues[int64](rows, column))
+	case uint64:
+		return sortIndexOrdered(columnValues[uint64](rows, column))
+	case float64:
+		return sortIndexOrdered(columnValues[float64](rows, column))
+	case time.Duration:
+		return sortIndexOrdered(columnValues[time.Duration](rows, column))
+	default:
+		// types that are not cmp.Ordered are compared one by one
+		index := sequence(0, len(rows))
+		slices.SortFunc(index, func(a, b int) int {
+			if c := compareOrdered(rows[a][column], rows[b][column]); c != 0 {
+				return c
+			}
+			return a - b
+		})
+		return index
+	}
+}
+
+// columnEqual returns equality check of the rows indexes by the column, values are extracted
+// the same as in sortIndexByColumn so the checks avoid normalizing the values on each call
+func columnEqual(rows [][]any, column int) func(a, b int) bool {
+	if len(rows) == 0 {
+		return func(a, b int) bool { return true }
+	}
+	switch normalizeOrdered(rows[0][column]).(type) {
+	case string:
+		return equalOrdered(columnValues[string](rows, column))
+	case int64:
+		return equalOrdered(columnValues[int64](rows, column))
+	case uint64:
+		return equalOrdered(columnValues[uint64](rows, column))
+	case float64:
+		return equalOrdered(columnValues[float64](rows, column))
+	case time.Duration:
+		return equalOrdered(columnValues[time.Duration](rows, column))
+	default:
+		return func(a, b int) bool {
+			return compareOrdered(rows[a][column], rows[b][column]) == 0
+		}
+	}
+}
+
+// columnValues extracts normalized values of the column
+func columnValues[T int64 | uint64 | float64 | string | time.Duration](rows [][]any, column int) []T {
+	values := make([]T, len(rows))
+	for i, row := range rows {
+		values[i] = normalizeOrdered(row[column]).(T)
+	}
+	return values
+}
+
+// sortIndexOrdered returns stably sorted permutation of the values indexes
+func sortIndexOrdered[T cmp.Ordered](values []T) []int {
+	index := sequence(0, len(values))
+	// ties are broken by the index so unstable sort yields the stable order
+	slices.SortFunc(index, func(a, b int) int {
+		if c := cmp.Compare(values[a], values[b]); c != 0 {
+			return c
+		}
+		return a - b
+	})
+	return index
 }
 
-func sortRows(rows [][]any, index int, orderKey SortingOrderKey) [][]any {
-	// sorted rows
-	var sorted [][]any
-	// list of column values used for ordering
-	var orderingCol []any
-	for _, rw := range rows {
-		orderingCol = append(orderingCol, rw[index])
+// equalOrdered returns equality check of the values indexes, NaN values are equal to each other
+// the same as when sorting
+func equalOrdered[T cmp.Ordered](values []T) func(a, b int) bool {
+	return func(a, b int) bool {
+		return cmp.Compare(values[a], values[b]) == 0
 	}
-	// get sorting index
-	sortingIndex := sortIndexByOrderedColumn(orderingCol, orderKey)
-	// update rows
-	for _, i := range sortingIndex {
-		sorted = append(sorted, rows[i])
+}
+
+// sortAppended merges rows appended from the index into cached and current permutations,
+// new rows are sorted on their own and merged so the cost is O(n + k log k) rather than O(n log n),
+// batches at least as large as the rows already there are sorted together with them instead
+func (r *Table) sortAppended(from int) {
+	resort := len(r.rows)-from >= from
+	for column, index := range r.sortCache {
+		if resort {
+			r.sortCache[column] = sortIndexByColumn(r.rows, column)
+			continue
+		}
+		added := sortIndexByColumn(r.rows[from:], column)
+		for i := range added {
+			added[i] += from
+		}
+		r.sortCache[column] = mergeSortedIndex(index, added, r.rowsIndexComparator([]SortKey{{Column: column}}))
 	}
-	return sorted
+	if r.sortIndex == nil {
+		return
+	}
+	if resort {
+		r.sort()
+		return
+	}
+	compare := r.rowsIndexComparator(r.sortKeys)
+	added := sequence(from, len(r.rows))
+	slices.SortFunc(added, compare)
+	r.sortIndex = mergeSortedIndex(r.sortIndex, added, compare)
+	r.updateSortedRows()
 }
 
-// isOrdered check if type is one of valid Ordered types
+// sortUpdated moves the row with the index to its new place in cached and current permutations
+// after its values have changed, each costs O(log n) comparisons plus the copy
+func (r *Table) sortUpdated(index int) {
+	for column, perm := range r.sortCache {
+		compare := r.rowsIndexComparator([]SortKey{{Column: column}})
+		r.sortCache[column] = mergeSortedIndex(removeFromIndex(perm, index, false), []int{index}, compare)
+	}
+	if r.sortIndex == nil {
+		return
+	}
+	compare := r.rowsIndexComparator(r.sortKeys)
+	r.sortIndex = mergeSortedIndex(removeFromIndex(r.sortIndex, index, false), []int{index}, compare)
+	r.updateSortedRows()
+}
+
+// sortRemoved removes the row with the index from cached and current permutations, it has to be called
+// after the row is removed from rows since indexes of the rows after it are shifted down
+func (r *Table) sortRemoved(index int) {
+	for column, perm := range r.sortCache {
+		r.sortCache[column] = removeFromIndex(perm, index, true)
+	}
+	if r.sortIndex == nil {
+		return
+	}
+	r.sortIndex = removeFromIndex(r.sortIndex, index, true)
+	r.updateSortedRows()
+}
+
+// resetSort drops the cached permutations, should be called when existing rows change
+func (r *Table) resetSort() {
+	r.sortCache = make(map[int][]int)
+	r.sort()
+}
+
+// updateSortedRows materializes rows in the order of the sort permutation
+func (r *Table) updateSortedRows() {
+	r.sortedRows = make([][]any, len(r.sortIndex))
+	for i, ri := range r.sortIndex {
+		r.sortedRows[i] = r.rows[ri]
+	}
+}
+
+// orderedRowIndex returns the index in rows of the row on position i in the sort order
+func (r *Table) orderedRowIndex(i int) int {
+	if r.sortIndex == nil {
+		return i
+	}
+	return r.sortIndex[i]
+}
+
+// orderedRows returns rows in the current sort order
+func (r *Table) orderedRows() [][]any {
+	if r.sortIndex == nil {
+		return r.rows
+	}
+	return r.sortedRows
+}
+
+// rowsIndexComparator returns comparator of the rows indexes by the keys, ties are broken
+// by the index so sorting with it is stable even with unstable sort algorithms
+func (r *Table) rowsIndexComparator(keys []SortKey) func(a, b int) int {
+	keys = r.resolveComparators(keys)
+	return func(a, b int) int {
+		if c := compareRows(r.rows[a], r.rows[b], keys); c != 0 {
+			return c
+		}
+		return a - b
+	}
+}
+
+// resolveComparators returns the copy of the keys where keys without comparator use the comparator of their column
+func (r *Table) resolveComparators(keys []SortKey) []SortKey {
+	resolved := make([]SortKey, len(keys))
+	for i, k := range keys {
+		if k.Comparator == nil {
+			k.Comparator = r.columns[k.Column].Comparator
+		}
+		resolved[i] = k
+	}
+	return resolved
+}
+
+// mergeSortedIndex merges two sorted permutations, on ties elements of a come first
+// so appending newer rows as b keeps the merge stable, insertion points of b are binary searched
+// so merging few new rows costs O(k log n) comparisons plus the copy
+func mergeSortedIndex(a, b []int, compare func(x, y int) int) []int {
+	merged := make([]int, 0, len(a)+len(b))
+	i := 0
+	for _, el := range b {
+		// first element of a that is greater than the element, ties stay before
+		pos := i + sort.Search(len(a)-i, func(n int) bool { return compare(el, a[i+n]) < 0 })
+		merged = append(merged, a[i:pos]...)
+		merged = append(merged, el)
+		i = pos
+	}
+	return append(merged, a[i:]...)
+}
+
+// removeFromIndex returns a copy of the permutation without the index, if shift is set
+// indexes greater than the removed one are decremented
+func removeFromIndex(perm []int, index int, shift bool) []int {
+	removed := make([]int, 0, len(perm))
+	for _, i := range perm {
+		switch {
+		case i == index:
+			continue
+		case shift && i > index:
+			removed = append(removed, i-1)
+		default:
+			removed = append(removed, i)
+		}
+	}
+	return removed
+}
+
+// reverseStable turns the ascending permutation into the descending one in O(n),
+// runs of equal elements are reversed as blocks so they keep their original order
+func reverseStable(index []int, equal func(a, b int) bool) []int {
+	reversed := make([]int, 0, len(index))
+	for end := len(index); end > 0; {
+		start := end - 1
+		for start > 0 && equal(index[start-1], index[end-1]) {
+			start--
+		}
+		reversed = append(reversed, index[start:end]...)
+		end = start
+	}
+	return reversed
+}
+
+// sequence returns slice of consecutive integers in the range [from, to)
+func sequence(from, to int) []int {
+	s := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+// compareRows compares rows key by key until the first key that is not equal
+func compareRows(a, b []any, keys []SortKey) int {
+	for _, k := range keys {
+		if c := k.compare(a[k.Column], b[k.Column]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// isOrdered check if type is one of the supported cell types, Ordered types, bool, time.Time,
+// time.Duration or a custom type implementing CellValue
 func isOrdered(e any) bool {
 	switch e.(type) {
-	case string, int, int8, int16, int32, float32, float64:
+	case string, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	case bool, time.Time, time.Duration, CellValue:
 		return true
 	default:
 		return false
 	}
 }
 
-// getStringFromOrdered returns string from interface that was produced with one of Ordered types
+// getStringFromOrdered returns string from interface that was produced with one of the supported cell types
 func getStringFromOrdered(i any) string {
 	switch i := i.(type) {
 	case string:
@@ -108,102 +457,115 @@ func getStringFromOrdered(i any) string {
 	case int32:
 		return strconv.Itoa(int(i))
 	case int64:
-		return strconv.Itoa(int(i))
+		return strconv.FormatInt(i, 10)
+	case uint, uint8, uint16, uint32, uint64:
+		return strconv.FormatUint(normalizeOrdered(i).(uint64), 10)
 	case float32:
-		// default precision of 24
-		return strconv.FormatFloat(float64(i), 'G', 0, 32)
+		// smallest number of decimals that represents the value exactly
+		return strconv.FormatFloat(float64(i), 'f', -1, 32)
 	case float64:
-		// default precision of 24
-		return strconv.FormatFloat(i, 'G', 0, 64)
+		return strconv.FormatFloat(i, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(i)
+	case time.Time:
+		return i.Format(timeLayouts[0])
+	case time.Duration:
+		return i.String()
+	case CellValue:
+		return i.String()
 	default:
 		return ""
 	}
 }
 
-// sortIndexByOrderedColumn casts to the one of Ordered type that is used on the column and sends to sorting
-// returns sorted index of elements rather than elements themselves
-func sortIndexByOrderedColumn(i []any, order SortingOrderKey) (sortedIndex []int) {
-	// if len of slice is 0 return empty sort order
-	if len(i) == 0 {
-		return sortedIndex
-	}
-
-	switch i[0].(type) {
-	case string:
-		var s []string
-		for _, el := range i {
-			s = append(s, el.(string))
-		}
-		return sortIndex(s, order)
+// normalizeOrdered widens the value of one of Ordered types to int64, uint64, float64 or string
+// so values of different Ordered types can be compared with compareOrdered, other types are returned as they are
+func normalizeOrdered(i any) any {
+	switch i := i.(type) {
 	case int:
-		var s []int
-		for _, el := range i {
-			s = append(s, el.(int))
-		}
-		return sortIndex(s, order)
+		return int64(i)
 	case int8:
-		var s []int8
-		for _, el := range i {
-			s = append(s, el.(int8))
-		}
-		return sortIndex(s, order)
+		return int64(i)
 	case int16:
-		var s []int16
-		for _, el := range i {
-			s = append(s, el.(int16))
-		}
-		return sortIndex(s, order)
+		return int64(i)
 	case int32:
-		var s []int32
-		for _, el := range i {
-			s = append(s, el.(int32))
-		}
-		return sortIndex(s, order)
+		return int64(i)
 	case int64:
-		var s []int64
-		for _, el := range i {
-			s = append(s, el.(int64))
-		}
-		return sortIndex(s, order)
+		return i
+	case uint:
+		return uint64(i)
+	case uint8:
+		return uint64(i)
+	case uint16:
+		return uint64(i)
+	case uint32:
+		return uint64(i)
 	case float32:
-		var s []float32
-		for _, el := range i {
-			s = append(s, el.(float32))
+		return float64(i)
+	default:
+		return i
+	}
+}
+
+// compareOrdered compares two values of one of the supported cell types, returns -1 if a is less than b,
+// 0 if they are equal and +1 if a is greater than b, values are normalized before comparing
+// so numeric values of different types can be compared with each other
+func compareOrdered(a, b any) int {
+	switch a := normalizeOrdered(a).(type) {
+	case string:
+		return cmp.Compare(a, b.(string))
+	case int64:
+		switch b := normalizeOrdered(b).(type) {
+		case float64:
+			return cmp.Compare(float64(a), b)
+		case uint64:
+			return -compareUnsigned(b, a)
+		default:
+			return cmp.Compare(a, b.(int64))
+		}
+	case uint64:
+		switch b := normalizeOrdered(b).(type) {
+		case float64:
+			return cmp.Compare(float64(a), b)
+		case int64:
+			return compareUnsigned(a, b)
+		default:
+			return cmp.Compare(a, b.(uint64))
 		}
-		return sortIndex(s, order)
 	case float64:
-		var s []float64
-		for _, el := range i {
-			s = append(s, el.(float64))
+		switch b := normalizeOrdered(b).(type) {
+		case int64:
+			return cmp.Compare(a, float64(b))
+		case uint64:
+			return cmp.Compare(a, float64(b))
+		default:
+			return cmp.Compare(a, b.(float64))
 		}
-		return sortIndex(s, order)
-
+	case bool:
+		// false is ordered before true
+		switch b := b.(bool); {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		default:
+			return 1
+		}
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case time.Duration:
+		return cmp.Compare(a, b.(time.Duration))
+	case CellValue:
+		return a.Compare(b.(CellValue))
 	default:
-		panic(fmt.Sprintf("type %s not subtype of Ordered", reflect.TypeOf(i[0]).String()))
+		panic(fmt.Sprintf("type %s not subtype of Ordered", reflect.TypeOf(a).String()))
 	}
 }
 
-// sortIndex is simple generic bubble sort, returns sorted index slice
-// bubble sort implemented for simplicity, if you need faster alg feel free to open a PR for it :zap:
-func sortIndex[T Ordered](slice []T, order SortingOrderKey) []int {
-	// could do this in sortIndexByOrderedColumn where we cycle through the slice anyhow
-	// tho I think this is cheap op and makes code a bit cleaner, worthy trade for now
-	var index []int
-	for i := 0; i < len(slice); i++ {
-		index = append(index, i)
+// compareUnsigned compares unsigned and signed value without overflowing either of them
+func compareUnsigned(a uint64, b int64) int {
+	if b < 0 {
+		return 1
 	}
-
-	// bubble sort slice and update index in a process
-	for i := len(slice); i > 0; i-- {
-		for j := 1; j < i; j++ {
-			if order == SortingOrderDescending && slice[j] < slice[j-1] {
-				slice[j], slice[j-1] = slice[j-1], slice[j]
-				index[j], index[j-1] = index[j-1], index[j]
-			} else if order == SortingOrderAscending && slice[j] > slice[j-1] {
-				slice[j], slice[j-1] = slice[j-1], slice[j]
-				index[j], index[j-1] = index[j-1], index[j]
-			}
-		}
-	}
-	return index
+	return cmp.Compare(a, uint64(b))
 }
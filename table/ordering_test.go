@@ -0,0 +1,76 @@
+package table
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var benchmarkSizes = []int{10_000, 100_000, 1_000_000}
+
+// benchmarkRows returns rows with int column that has runs of equal values and string column
+func benchmarkRows(n int) [][]any {
+	random := rand.New(rand.NewSource(1))
+	rows := make([][]any, n)
+	for i := range rows {
+		id := random.Intn(n / 10)
+		rows[i] = []any{id, fmt.Sprintf("name-%d", id)}
+	}
+	return rows
+}
+
+// benchmarkTable returns table with n rows of benchmarkRows
+func benchmarkTable(b *testing.B, n int) *Table {
+	b.Helper()
+	t, err := NewTableWithColumns(80, 20, []Column{{Header: "id", Type: 0}, {Header: "name", Type: ""}})
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := t.AddRows(benchmarkRows(n)); err != nil {
+		b.Fatal(err)
+	}
+	return t
+}
+
+func BenchmarkOrderByAsc(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			t := benchmarkTable(b, n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				t.OrderByAsc(0)
+			}
+		})
+	}
+}
+
+func BenchmarkOrderByDesc(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			t := benchmarkTable(b, n)
+			// descending order is the reversed cached ascending permutation
+			t.OrderByAsc(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				t.OrderByDesc(0)
+			}
+		})
+	}
+}
+
+func BenchmarkAddRowsSorted(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			rows := benchmarkRows(n)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				t := benchmarkTable(b, 0)
+				t.OrderByAsc(0)
+				b.StartTimer()
+				if _, err := t.AddRows(rows); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
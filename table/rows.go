@@ -0,0 +1,172 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+)
+
+// RowKey uniquely identifies a row of the table, it's given to the row when it's added
+// and does not change when the row is sorted, filtered or updated
+type RowKey uint64
+
+// InsertRow adds a single row and returns its key
+func (r *Table) InsertRow(cells ...any) (RowKey, error) {
+	if r.source != nil {
+		return 0, ErrorDataSource{msg: "rows can't be added to the table backed by a data source"}
+	}
+	if err := r.validateRow(cells...); err != nil {
+		return 0, err
+	}
+	from := len(r.rows)
+	r.appendRow(slices.Clone(cells))
+	r.sortAppended(from)
+	r.matchRows(from)
+
+	r.applyFilter()
+	r.setRowsUpdate()
+	return r.lastRowKey, nil
+}
+
+// UpdateRow replaces all the cells of the row with the key
+func (r *Table) UpdateRow(key RowKey, cells ...any) (*Table, error) {
+	index, err := r.rowIndex(key)
+	if err != nil {
+		return r, err
+	}
+	if err := r.validateRow(cells...); err != nil {
+		return r, err
+	}
+	r.replaceRow(index, slices.Clone(cells))
+	delete(r.missing, key)
+	return r, nil
+}
+
+// UpdateCell replaces a single cell of the row with the key
+func (r *Table) UpdateCell(key RowKey, columnIndex int, value any) (*Table, error) {
+	index, err := r.rowIndex(key)
+	if err != nil {
+		return r, err
+	}
+	if columnIndex < 0 || columnIndex >= len(r.columns) {
+		message := fmt.Sprintf("column index %d out of range", columnIndex)
+		return r, ErrorBadColumnIndex{msg: message}
+	}
+	row := slices.Clone(r.rows[index])
+	row[columnIndex] = value
+	if err := r.validateRow(row...); err != nil {
+		return r, err
+	}
+	r.replaceRow(index, row)
+	delete(r.missing[key], columnIndex)
+	return r, nil
+}
+
+// DeleteRow removes the row with the key, if the cursor is on the row it moves to the next visible row
+func (r *Table) DeleteRow(key RowKey) (*Table, error) {
+	index, err := r.rowIndex(key)
+	if err != nil {
+		return r, err
+	}
+
+	// find the row cursor should stay on, and account for indexes shifting after removal
+	cursorRow := r.cursorRowIndex()
+	if cursorRow == index {
+		cursorRow = -1
+		if r.cursorIndexY+1 < len(r.filteredRows) {
+			cursorRow = r.filteredRowIndex(r.cursorIndexY + 1)
+		} else if r.cursorIndexY > 0 {
+			cursorRow = r.filteredRowIndex(r.cursorIndexY - 1)
+		}
+	}
+	if cursorRow > index {
+		cursorRow--
+	}
+
+	r.rows = slices.Delete(r.rows, index, index+1)
+	r.rowKeys = slices.Delete(r.rowKeys, index, index+1)
+	r.rowMatches = slices.Delete(r.rowMatches, index, index+1)
+	delete(r.rowKeyIndex, key)
+	delete(r.selected, key)
+	delete(r.missing, key)
+	for i, k := range r.rowKeys[index:] {
+		r.rowKeyIndex[k] = index + i
+	}
+	r.sortRemoved(index)
+	r.setAutoFitUpdate()
+
+	// current filtered rows hold the removed row, make sure nothing is kept from it
+	r.filteredRows, r.filteredIndex = nil, nil
+	r.applyFilterWithCursor(cursorRow)
+	r.setRowsUpdate()
+	return r, nil
+}
+
+// GetRow returns a copy of the row with the key, and if the row exists
+func (r *Table) GetRow(key RowKey) ([]any, bool) {
+	index, err := r.rowIndex(key)
+	if err != nil {
+		return nil, false
+	}
+	return slices.Clone(r.rows[index]), true
+}
+
+// GetCursorRowKey returns the key of the row under the cursor, false if there are no visible rows
+func (r *Table) GetCursorRowKey() (RowKey, bool) {
+	index := r.cursorRowIndex()
+	if index < 0 {
+		return 0, false
+	}
+	return r.rowKeys[index], true
+}
+
+// GetRowKeys returns keys of the visible rows in the order they are shown
+func (r *Table) GetRowKeys() []RowKey {
+	keys := make([]RowKey, len(r.filteredRows))
+	for i := range keys {
+		keys[i] = r.rowKeys[r.filteredRowIndex(i)]
+	}
+	return keys
+}
+
+// appendRow appends the row to the rows and gives it a key, sorting and filtering are not updated
+func (r *Table) appendRow(row []any) {
+	r.setAutoFitUpdate()
+	r.lastRowKey++
+	r.rows = append(r.rows, row)
+	r.rowKeys = append(r.rowKeys, r.lastRowKey)
+	r.rowKeyIndex[r.lastRowKey] = len(r.rows) - 1
+}
+
+// replaceRow replaces the row on the index and updates sorting and filtering for it only
+func (r *Table) replaceRow(index int, row []any) {
+	r.setAutoFitUpdate()
+	r.rows[index] = row
+	r.sortUpdated(index)
+	matched, score := r.matchFilters(row)
+	r.rowMatches[index] = rowMatch{matched: matched, score: score}
+
+	r.applyFilter()
+	r.setRowsUpdate()
+}
+
+// resetRows removes all the rows along with their keys
+func (r *Table) resetRows() {
+	r.setAutoFitUpdate()
+	r.rows = make([][]any, 0, 10)
+	r.rowKeys = nil
+	r.rowKeyIndex = make(map[RowKey]int)
+	r.rowMatches = nil
+	r.filteredRows, r.filteredIndex = nil, nil
+	r.selected = make(map[RowKey]struct{})
+	r.missing = nil
+	r.resetSelectionRange()
+}
+
+// rowIndex returns the index in rows of the row with the key
+func (r *Table) rowIndex(key RowKey) (int, error) {
+	index, ok := r.rowKeyIndex[key]
+	if !ok {
+		return -1, ErrorRowNotFound{msg: fmt.Sprintf("row with key %d not found", key)}
+	}
+	return index, nil
+}
@@ -0,0 +1,154 @@
+package table
+
+import "slices"
+
+// SelectionMsg is sent to the parent model when the selection of the rows changes
+type SelectionMsg struct {
+	// Keys of the selected rows in the current sort order
+	Keys []RowKey
+}
+
+// ToggleCursorSelection selects the row under the cursor, or deselects it if it's already selected
+func (r *Table) ToggleCursorSelection() *Table {
+	r.resetSelectionRange()
+	if key, ok := r.GetCursorRowKey(); ok {
+		r.ToggleRowSelection(key)
+	}
+	return r
+}
+
+// ToggleRowSelection selects the row with the key, or deselects it if it's already selected
+func (r *Table) ToggleRowSelection(key RowKey) *Table {
+	if r.IsRowSelected(key) {
+		return r.DeselectRow(key)
+	}
+	return r.SelectRow(key)
+}
+
+// SelectRow adds the row with the key to the selection, unknown keys are ignored
+func (r *Table) SelectRow(key RowKey) *Table {
+	if _, ok := r.rowKeyIndex[key]; ok {
+		r.selected[key] = struct{}{}
+		r.setRowsUpdate()
+	}
+	return r
+}
+
+// DeselectRow removes the row with the key from the selection
+func (r *Table) DeselectRow(key RowKey) *Table {
+	delete(r.selected, key)
+	r.setRowsUpdate()
+	return r
+}
+
+// IsRowSelected checks if the row with the key is selected
+func (r *Table) IsRowSelected(key RowKey) bool {
+	_, ok := r.selected[key]
+	return ok
+}
+
+// SelectAll selects all the visible rows, rows that are filtered out keep their selection state
+func (r *Table) SelectAll() *Table {
+	r.resetSelectionRange()
+	for _, key := range r.GetRowKeys() {
+		r.selected[key] = struct{}{}
+	}
+	r.setRowsUpdate()
+	return r
+}
+
+// SelectNone clears the selection, including the rows that are filtered out
+func (r *Table) SelectNone() *Table {
+	r.resetSelectionRange()
+	clear(r.selected)
+	r.setRowsUpdate()
+	return r
+}
+
+// InvertSelection inverts the selection of the visible rows, rows that are filtered out keep their selection state
+func (r *Table) InvertSelection() *Table {
+	r.resetSelectionRange()
+	for _, key := range r.GetRowKeys() {
+		r.ToggleRowSelection(key)
+	}
+	return r
+}
+
+// ExtendSelectionUp moves the cursor up and selects the rows between the row where the range started
+// and the cursor, moving back towards the start shrinks the range
+func (r *Table) ExtendSelectionUp() *Table {
+	return r.extendSelection(r.CursorUp)
+}
+
+// ExtendSelectionDown moves the cursor down and selects the rows between the row where the range started
+// and the cursor, moving back towards the start shrinks the range
+func (r *Table) ExtendSelectionDown() *Table {
+	return r.extendSelection(r.CursorDown)
+}
+
+// GetSelectedRowKeys returns keys of all the selected rows in the current sort order,
+// including the ones that are filtered out
+func (r *Table) GetSelectedRowKeys() []RowKey {
+	var keys []RowKey
+	for i := range r.orderedRows() {
+		if key := r.rowKeys[r.orderedRowIndex(i)]; r.IsRowSelected(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// GetSelectedRows returns copies of all the selected rows in the current sort order,
+// including the ones that are filtered out, cells keep the types of their columns
+func (r *Table) GetSelectedRows() [][]any {
+	var rows [][]any
+	for i, row := range r.orderedRows() {
+		if r.IsRowSelected(r.rowKeys[r.orderedRowIndex(i)]) {
+			rows = append(rows, slices.Clone(row))
+		}
+	}
+	return rows
+}
+
+// extendSelection moves the cursor with the move function and reselects the range
+func (r *Table) extendSelection(move func() *Table) *Table {
+	anchor, ok := r.GetCursorRowKey()
+	if !ok {
+		return r
+	}
+	base := r.selectionBase
+	if r.selectionAnchor != 0 {
+		anchor = r.selectionAnchor
+	} else {
+		// remember the selection before the range so shrinking the range does not deselect it
+		base = make(map[RowKey]struct{}, len(r.selected))
+		for key := range r.selected {
+			base[key] = struct{}{}
+		}
+	}
+	// cursor moves end the range, it's restored below
+	move()
+
+	anchorY := r.filteredPosition(r.rowKeyIndex[anchor])
+	if anchorY < 0 {
+		r.resetSelectionRange()
+		return r
+	}
+	r.selected = make(map[RowKey]struct{}, len(base))
+	for key := range base {
+		r.selected[key] = struct{}{}
+	}
+	from, to := min(anchorY, r.cursorIndexY), max(anchorY, r.cursorIndexY)
+	for y := from; y <= to; y++ {
+		r.selected[r.rowKeys[r.filteredRowIndex(y)]] = struct{}{}
+	}
+	r.selectionAnchor, r.selectionBase = anchor, base
+	r.setRowsUpdate()
+	return r
+}
+
+// resetSelectionRange ends the range selection, next extend starts a new range from the cursor
+func (r *Table) resetSelectionRange() {
+	r.selectionAnchor = 0
+	r.selectionBase = nil
+}
@@ -0,0 +1,271 @@
+package table
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// StaticOptions configure rendering the table with RenderStatic
+type StaticOptions struct {
+	// MaxWidth limits the width of the table including the borders, the widest columns are shrunk until
+	// the table fits, when it's 0 the table is sized to its content
+	MaxWidth int
+	// Border is drawn around the table, between the columns and under the header,
+	// when it's not set the columns are separated by a space
+	Border lipgloss.Border
+	// RowSeparators draws the border between each of the rows as well
+	RowSeparators bool
+	// NoHeader leaves out the header row
+	NoHeader bool
+	// Rows chooses which rows are rendered
+	Rows RowSet
+	// ASCII renders the table without styles and with ASCII border, it's turned on when the output
+	// does not support colors
+	ASCII bool
+}
+
+// ASCIIBorder returns border made of ASCII characters
+func ASCIIBorder() lipgloss.Border {
+	return lipgloss.Border{
+		Top:          "-",
+		Bottom:       "-",
+		Left:         "|",
+		Right:        "|",
+		TopLeft:      "+",
+		TopRight:     "+",
+		BottomLeft:   "+",
+		BottomRight:  "+",
+		MiddleLeft:   "+",
+		MiddleRight:  "+",
+		Middle:       "+",
+		MiddleTop:    "+",
+		MiddleBottom: "+",
+	}
+}
+
+// isASCIIBorder checks if the border is made of ASCII characters only
+func isASCIIBorder(border lipgloss.Border) bool {
+	for _, part := range []string{
+		border.Top, border.Bottom, border.Left, border.Right,
+		border.TopLeft, border.TopRight, border.BottomLeft, border.BottomRight,
+		border.MiddleLeft, border.MiddleRight, border.Middle, border.MiddleTop, border.MiddleBottom,
+	} {
+		for _, c := range part {
+			if c > unicode.MaxASCII {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// staticTable holds the cells of the table that is rendered statically
+type staticTable struct {
+	opts    StaticOptions
+	columns []int
+	widths  []int
+	header  []string
+	rows    []RowState
+	cells   [][]string
+}
+
+// RenderStatic renders the table for the non-interactive output, e.g. printing it to stdout, all the rows
+// are rendered without scrolling, cursor and footer, cells keep the row and cell styles and are aligned
+// and formatted the same as in the interactive table
+func (r *Table) RenderStatic(opts StaticOptions) string {
+	if lipgloss.ColorProfile() == termenv.Ascii {
+		opts.ASCII = true
+	}
+	if opts.ASCII && !isASCIIBorder(opts.Border) {
+		opts.Border = ASCIIBorder()
+	}
+	s := r.newStaticTable(opts)
+
+	var lines []string
+	hasBorder := opts.Border != (lipgloss.Border{})
+	if hasBorder {
+		lines = append(lines, s.borderLine(opts.Border.TopLeft, opts.Border.Top, opts.Border.MiddleTop, opts.Border.TopRight))
+	}
+	if !opts.NoHeader {
+		style := r.styles[StyleKeyHeader]
+		styles := make([]lipgloss.Style, len(s.columns))
+		for i := range styles {
+			styles[i] = style
+		}
+		lines = append(lines, s.rowLine(s.header, style, styles))
+		if hasBorder && len(s.cells) > 0 {
+			lines = append(lines, s.middleLine())
+		}
+	}
+	for i, state := range s.rows {
+		if i > 0 && hasBorder && opts.RowSeparators {
+			lines = append(lines, s.middleLine())
+		}
+		rowStyle := r.rowStyle(state)
+		styles := make([]lipgloss.Style, len(s.columns))
+		for j, column := range s.columns {
+			styles[j] = rowStyle
+			cellState := CellState{RowState: state, Column: column, Value: state.Row[column]}
+			if style, ok := r.cellStyle(cellState, rowStyle); ok {
+				styles[j] = style
+			}
+		}
+		lines = append(lines, s.rowLine(s.cells[i], rowStyle, styles))
+	}
+	if hasBorder {
+		lines = append(lines, s.borderLine(opts.Border.BottomLeft, opts.Border.Bottom, opts.Border.MiddleBottom, opts.Border.BottomRight))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// newStaticTable formats the cells and sizes the columns to the content
+func (r *Table) newStaticTable(opts StaticOptions) *staticTable {
+	s := &staticTable{opts: opts, columns: r.exportColumns(false)}
+	s.widths = make([]int, len(s.columns))
+	s.header = make([]string, len(s.columns))
+	for i, index := range s.columns {
+		s.header[i] = r.columns[index].Header
+		if !opts.NoHeader {
+			s.widths[i] = lipgloss.Width(s.header[i])
+		}
+	}
+	s.rows = r.exportRows(opts.Rows)
+	for _, row := range s.rows {
+		cells := make([]string, len(s.columns))
+		for i, column := range s.columns {
+			cells[i] = strings.ReplaceAll(r.formatCell(column, row.Row[column]), "\n", " ")
+			s.widths[i] = max(s.widths[i], lipgloss.Width(cells[i]))
+		}
+		s.cells = append(s.cells, cells)
+	}
+	for i, index := range s.columns {
+		column := r.columns[index]
+		if column.MaxWidth > 0 {
+			s.widths[i] = min(s.widths[i], column.MaxWidth)
+		}
+		s.widths[i] = max(s.widths[i], column.MinWidth, 1)
+	}
+	s.fit()
+
+	for i, index := range s.columns {
+		s.header[i] = r.staticCell(index, s.header[i], s.widths[i], opts.ASCII)
+		for _, cells := range s.cells {
+			cells[i] = r.staticCell(index, cells[i], s.widths[i], opts.ASCII)
+		}
+	}
+	return s
+}
+
+// fit shrinks the widest columns until the table fits the max width
+func (s *staticTable) fit() {
+	if s.opts.MaxWidth <= 0 {
+		return
+	}
+	width := s.width()
+	for width > s.opts.MaxWidth {
+		widest := 0
+		for i, w := range s.widths {
+			if w > s.widths[widest] {
+				widest = i
+			}
+		}
+		if s.widths[widest] <= 1 {
+			return
+		}
+		s.widths[widest]--
+		width--
+	}
+}
+
+// width returns the width of the table
+func (s *staticTable) width() int {
+	width := 0
+	for _, w := range s.widths {
+		width += w
+	}
+	if s.opts.Border == (lipgloss.Border{}) {
+		// columns are separated by a space
+		return width + len(s.widths) - 1
+	}
+	// cells are padded by a space on each side and separated by the border
+	return width + len(s.widths)*3 + 1
+}
+
+// staticCell truncates the content to the width and aligns it the same as the column
+func (r *Table) staticCell(columnIndex int, content string, width int, ascii bool) string {
+	if lipgloss.Width(content) > width {
+		ellipsis := "…"
+		if ascii {
+			ellipsis = "~"
+		}
+		if width <= 1 {
+			// max width of 0 means no limit, so the cell too narrow for any of the content is only the ellipsis
+			return ellipsis
+		}
+		content = lipgloss.NewStyle().MaxWidth(width-1).Render(content) + ellipsis
+	}
+	pos := r.columnAlignment(columnIndex).position()
+	return lipgloss.PlaceHorizontal(width, pos, content)
+}
+
+// rowLine joins the cells of the row, the space around the cells is rendered with the row style
+func (s *staticTable) rowLine(cells []string, rowStyle lipgloss.Style, styles []lipgloss.Style) string {
+	render := func(style lipgloss.Style, content string) string {
+		if s.opts.ASCII {
+			return content
+		}
+		return inlineStyle(style).Render(content)
+	}
+	var b strings.Builder
+	border := s.opts.Border
+	if border == (lipgloss.Border{}) {
+		for i, cell := range cells {
+			if i > 0 {
+				b.WriteString(render(rowStyle, " "))
+			}
+			b.WriteString(render(styles[i], cell))
+		}
+		return b.String()
+	}
+	for i, cell := range cells {
+		// left border separates the columns as well
+		b.WriteString(border.Left)
+		b.WriteString(render(rowStyle, " "))
+		b.WriteString(render(styles[i], cell))
+		b.WriteString(render(rowStyle, " "))
+	}
+	b.WriteString(border.Right)
+	return b.String()
+}
+
+// middleLine returns the border line drawn between the rows
+func (s *staticTable) middleLine() string {
+	border := s.opts.Border
+	return s.borderLine(border.MiddleLeft, border.Top, border.Middle, border.MiddleRight)
+}
+
+// borderLine returns the horizontal border line made of the edge, fill and separator characters
+func (s *staticTable) borderLine(left, fill, separator, right string) string {
+	parts := make([]string, len(s.widths))
+	for i, w := range s.widths {
+		parts[i] = strings.Repeat(fill, w+2)
+	}
+	return left + strings.Join(parts, separator) + right
+}
+
+// inlineStyle returns the style with only the colors and text attributes of the given style,
+// layout like padding and width is left out so the cells keep their size
+func inlineStyle(style lipgloss.Style) lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(style.GetForeground()).
+		Background(style.GetBackground()).
+		Bold(style.GetBold()).
+		Italic(style.GetItalic()).
+		Underline(style.GetUnderline()).
+		Strikethrough(style.GetStrikethrough()).
+		Faint(style.GetFaint()).
+		Reverse(style.GetReverse())
+}
@@ -0,0 +1,310 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StructTable is a Table bound to the struct type T, rows are added as structs and read back as structs,
+// T can be a struct or a pointer to a struct
+type StructTable[T any] struct {
+	*Table
+	// fields holds the index path of the struct field for each of the columns
+	fields [][]int
+	// items holds the struct each of the rows was added from
+	items map[RowKey]T
+}
+
+// FromStructs initialize StructTable with the columns derived from the exported fields of T and adds
+// the rows, columns are configured with the `table` struct tag, e.g. `table:"First Name,ratio=10,min=5"`:
+//
+//	header  first value of the tag, name of the field is used when it's empty, "-" skips the field
+//	ratio   width ratio of the column
+//	min     min width of the column
+//	max     max width of the column
+//	align   one of auto, left, center or right
+//	format  one of float:P, thousands:P, percent:P or currency:SYMBOL:P where P is the precision
+//	frozen  freezes the column
+//	hidden  hides the column
+//
+// error is of type ErrorBadColumn if the tag or the type of the field is not valid
+func FromStructs[T any](rows []T) (*StructTable[T], error) {
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nil, ErrorBadType{msg: fmt.Sprintf("type %s is not a struct", structType.String())}
+	}
+	columns, fields, err := structColumns(structType)
+	if err != nil {
+		return nil, err
+	}
+	t, err := NewTableWithColumns(0, 0, columns)
+	if err != nil {
+		return nil, err
+	}
+	s := &StructTable[T]{Table: t, fields: fields, items: make(map[RowKey]T)}
+	if _, err := s.AddStructs(rows); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// AddStructs adds the structs as rows, nothing is added if any of them is not valid
+func (s *StructTable[T]) AddStructs(rows []T) (*StructTable[T], error) {
+	cells := make([][]any, len(rows))
+	for i, row := range rows {
+		v := reflect.ValueOf(&row).Elem()
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return s, ErrorBadType{msg: fmt.Sprintf("row on index %d is nil", i)}
+			}
+			v = v.Elem()
+		}
+		cells[i] = s.structCells(v)
+	}
+	from := len(s.rows)
+	if _, err := s.AddRows(cells); err != nil {
+		return s, err
+	}
+	for i, key := range s.rowKeys[from:] {
+		s.items[key] = rows[i]
+	}
+	return s, nil
+}
+
+// UpdateStruct replaces the row with the key with the struct
+func (s *StructTable[T]) UpdateStruct(key RowKey, row T) (*StructTable[T], error) {
+	v := reflect.ValueOf(&row).Elem()
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return s, ErrorBadType{msg: "row is nil"}
+		}
+		v = v.Elem()
+	}
+	if _, err := s.Table.UpdateRow(key, s.structCells(v)...); err != nil {
+		return s, err
+	}
+	s.items[key] = row
+	return s, nil
+}
+
+// UpdateRow replaces all the cells of the row with the key, see Table.UpdateRow
+func (s *StructTable[T]) UpdateRow(key RowKey, cells ...any) (*StructTable[T], error) {
+	if _, err := s.Table.UpdateRow(key, cells...); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+// UpdateCell replaces a single cell of the row with the key, see Table.UpdateCell
+func (s *StructTable[T]) UpdateCell(key RowKey, columnIndex int, value any) (*StructTable[T], error) {
+	if _, err := s.Table.UpdateCell(key, columnIndex, value); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+// GetStruct returns the copy of the struct of the row with the key, and if the row exists, fields bound
+// to the columns hold the current values of the cells, the struct the row was added from is not modified
+// even when T is a pointer
+func (s *StructTable[T]) GetStruct(key RowKey) (T, bool) {
+	cells, ok := s.GetRow(key)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return s.rowStruct(key, cells), true
+}
+
+// GetCursorRow returns the struct of the row under the cursor, false if there are no visible rows
+func (s *StructTable[T]) GetCursorRow() (T, bool) {
+	key, ok := s.GetCursorRowKey()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return s.GetStruct(key)
+}
+
+// GetSelected returns the structs of the selected rows in the order they are sorted in
+func (s *StructTable[T]) GetSelected() []T {
+	keys := s.GetSelectedRowKeys()
+	selected := make([]T, 0, len(keys))
+	for _, key := range keys {
+		if row, ok := s.GetStruct(key); ok {
+			selected = append(selected, row)
+		}
+	}
+	return selected
+}
+
+// DeleteRow removes the row with the key and the struct it was added from
+func (s *StructTable[T]) DeleteRow(key RowKey) (*StructTable[T], error) {
+	if _, err := s.Table.DeleteRow(key); err != nil {
+		return s, err
+	}
+	delete(s.items, key)
+	return s, nil
+}
+
+// ClearRows removes all the rows and the structs they were added from
+func (s *StructTable[T]) ClearRows() *StructTable[T] {
+	s.Table.ClearRows()
+	clear(s.items)
+	return s
+}
+
+// structCells returns the values of the fields bound to the columns
+func (s *StructTable[T]) structCells(v reflect.Value) []any {
+	cells := make([]any, len(s.fields))
+	for i, path := range s.fields {
+		field, err := v.FieldByIndexErr(path)
+		if err != nil {
+			// field is promoted through a nil embedded pointer
+			field = reflect.Zero(v.Type().FieldByIndex(path).Type)
+		}
+		cells[i] = field.Interface()
+	}
+	return cells
+}
+
+// rowStruct returns the copy of the struct the row was added from with the values of the cells set,
+// rows that were not added from a struct get a new one
+func (s *StructTable[T]) rowStruct(key RowKey, cells []any) T {
+	item, ok := s.items[key]
+	v := reflect.ValueOf(&item).Elem()
+	if v.Kind() == reflect.Pointer {
+		// pointer is replaced by the pointer to the copy so the original struct is not modified
+		copied := reflect.New(v.Type().Elem())
+		if ok && !v.IsNil() {
+			copied.Elem().Set(v.Elem())
+		}
+		v.Set(copied)
+		v = v.Elem()
+	}
+	for i, path := range s.fields {
+		field, err := v.FieldByIndexErr(path)
+		if err != nil || !field.CanSet() {
+			continue
+		}
+		field.Set(reflect.ValueOf(cells[i]))
+	}
+	return item
+}
+
+// structColumns derives the columns and the index paths of their fields from the struct type
+func structColumns(structType reflect.Type) ([]Column, [][]int, error) {
+	var columns []Column
+	var fields [][]int
+	for _, field := range reflect.VisibleFields(structType) {
+		tag, tagged := field.Tag.Lookup("table")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		columnType := reflect.Zero(field.Type).Interface()
+		// fields of embedded structs are promoted and added on their own
+		if field.Anonymous && !tagged && !isOrdered(columnType) {
+			continue
+		}
+		column, err := parseColumnTag(field.Name, tag)
+		if err != nil {
+			return nil, nil, err
+		}
+		column.Type = columnType
+		if !isOrdered(column.Type) {
+			return nil, nil, ErrorBadColumn{msg: fmt.Sprintf(
+				"field %s of type %s is not one of the supported cell types", field.Name, field.Type.String(),
+			)}
+		}
+		columns = append(columns, column)
+		fields = append(fields, field.Index)
+	}
+	if len(columns) == 0 {
+		return nil, nil, ErrorBadColumn{msg: fmt.Sprintf("struct %s has no exported fields", structType.String())}
+	}
+	return columns, fields, nil
+}
+
+// parseColumnTag parses the `table` struct tag of the field into the column
+func parseColumnTag(fieldName, tag string) (Column, error) {
+	options := strings.Split(tag, ",")
+	column := Column{Header: strings.TrimSpace(options[0])}
+	if column.Header == "" {
+		column.Header = fieldName
+	}
+	for _, option := range options[1:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		var err error
+		switch name {
+		case "ratio":
+			column.Ratio, err = strconv.Atoi(value)
+		case "min":
+			column.MinWidth, err = strconv.Atoi(value)
+		case "max":
+			column.MaxWidth, err = strconv.Atoi(value)
+		case "align":
+			column.Align, err = parseAlignment(value)
+		case "format":
+			column.Formatter, err = parseFormatter(value)
+		case "frozen":
+			column.Frozen = true
+		case "hidden":
+			column.Hidden = true
+		case "":
+			continue
+		default:
+			err = fmt.Errorf("unknown option")
+		}
+		if err != nil {
+			return column, ErrorBadColumn{msg: fmt.Sprintf("option %q of field %s is not valid", option, fieldName)}
+		}
+	}
+	return column, nil
+}
+
+// parseAlignment parses the name of the alignment
+func parseAlignment(s string) (Alignment, error) {
+	switch s {
+	case "auto":
+		return AlignAuto, nil
+	case "left":
+		return AlignLeft, nil
+	case "center":
+		return AlignCenter, nil
+	case "right":
+		return AlignRight, nil
+	default:
+		return AlignAuto, fmt.Errorf("unknown alignment %q", s)
+	}
+}
+
+// parseFormatter parses the formatter in the form of name:args, e.g. thousands:2 or currency:$:2
+func parseFormatter(s string) (Formatter, error) {
+	args := strings.Split(s, ":")
+	precision := func(i int) (int, error) {
+		if len(args) != i+1 {
+			return 0, fmt.Errorf("formatter %q takes %d arguments", args[0], i)
+		}
+		return strconv.Atoi(args[i])
+	}
+	switch args[0] {
+	case "float":
+		p, err := precision(1)
+		return FormatFloat(p), err
+	case "thousands":
+		p, err := precision(1)
+		return FormatThousands(p), err
+	case "percent":
+		p, err := precision(1)
+		return FormatPercent(p), err
+	case "currency":
+		p, err := precision(2)
+		return FormatCurrency(args[1], p), err
+	default:
+		return nil, fmt.Errorf("unknown formatter %q", args[0])
+	}
+}
@@ -0,0 +1,97 @@
+package table
+
+import "github.com/charmbracelet/lipgloss"
+
+// RowState is the state of the rendered row passed to the RowStyleFunc
+type RowState struct {
+	Key RowKey
+	// Row holds the typed values of the row, it must not be modified
+	Row []any
+	// Index is the index of the row in the filtered and sorted rows, same as cursor Y
+	Index    int
+	Cursor   bool
+	Selected bool
+}
+
+// CellState is the state of the rendered cell passed to the CellStyleFunc
+type CellState struct {
+	RowState
+	// Column is the index of the column of the cell, Value is the typed value of the cell
+	Column int
+	Value  any
+	// CursorCell is true if the cursor is on the cell, Cursor is true if it's on the row of the cell
+	CursorCell bool
+}
+
+// RowStyleFunc returns the style of the row, it's layered on top of the striping style
+// and below the selected and cursor styles, properties that are not set are taken from the layer below
+type RowStyleFunc func(state RowState) lipgloss.Style
+
+// CellStyleFunc returns the style of the cell, it's layered on top of the row style
+// and below the cell cursor style, properties that are not set are taken from the row
+type CellStyleFunc func(state CellState) lipgloss.Style
+
+// SetRowStyleFunc sets the function that styles the rows depending on their values and state, nil removes it
+func (r *Table) SetRowStyleFunc(f RowStyleFunc) *Table {
+	r.rowStyleFunc = f
+	r.setRowsUpdate()
+	return r
+}
+
+// SetCellStyleFunc sets the function that styles the cells depending on their values and state, nil removes it
+func (r *Table) SetCellStyleFunc(f CellStyleFunc) *Table {
+	r.cellStyleFunc = f
+	r.setRowsUpdate()
+	return r
+}
+
+// rowState returns the state of the row on the index in the filtered rows
+func (r *Table) rowState(index int) RowState {
+	if r.source != nil {
+		// rows of the data source have no keys and can't be selected
+		return RowState{Row: r.viewRow(index), Index: index, Cursor: index == r.cursorIndexY}
+	}
+	key := r.rowKeys[r.filteredRowIndex(index)]
+	return RowState{
+		Key:      key,
+		Row:      r.filteredRows[index],
+		Index:    index,
+		Cursor:   index == r.cursorIndexY,
+		Selected: r.IsRowSelected(key),
+	}
+}
+
+// rowStyle layers striping, row style func, selected and cursor styles of the row
+func (r *Table) rowStyle(state RowState) lipgloss.Style {
+	// normal and subsequent rows should differ for readability
+	// TODO: make this ^ optional
+	var style lipgloss.Style
+	if state.Index%2 == 0 {
+		style = r.styles[StyleKeyRowsSubsequent]
+	} else {
+		style = r.styles[StyleKeyRows]
+	}
+	if r.rowStyleFunc != nil {
+		style = r.rowStyleFunc(state).Inherit(style)
+	}
+	if state.Selected {
+		style = r.styles[StyleKeyRowsSelected].Inherit(style)
+	}
+	if state.Cursor {
+		style = r.styles[StyleKeyRowsCursor].Inherit(style)
+	}
+	return style
+}
+
+// cellStyle layers cell style func and cell cursor style of the cell, ok is false if the cell has no style
+// of its own and inherits the row style
+func (r *Table) cellStyle(state CellState, rowStyle lipgloss.Style) (style lipgloss.Style, ok bool) {
+	if r.cellStyleFunc != nil {
+		// row style is inherited explicitly since it's not passed to the cells unless style passing is on
+		style, ok = r.cellStyleFunc(state).Inherit(rowStyle), true
+	}
+	if state.CursorCell {
+		style, ok = r.styles[StyleKeyCellCursor].Inherit(style), true
+	}
+	return style, ok
+}
@@ -6,10 +6,12 @@ import (
 	"log"
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/76creates/stickers/flexbox"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -28,9 +30,18 @@ var (
 		Background(lipgloss.Color("#f7b731")).
 		Foreground(lipgloss.Color("#000000")).
 		Bold(true)
+	tableDefaultRowsSelectedStyle = lipgloss.NewStyle().
+		Background(lipgloss.Color("#3867d6")).
+		Foreground(lipgloss.Color("#ffffff"))
 	tableDefaultCellCursorStyle = lipgloss.NewStyle().
 		Background(lipgloss.Color("#f6e58d")).
 		Foreground(lipgloss.Color("#000000"))
+	tableDefaultCellEditStyle = lipgloss.NewStyle().
+		Background(lipgloss.Color("#ffffff")).
+		Foreground(lipgloss.Color("#000000"))
+	tableDefaultCellEditErrorStyle = lipgloss.NewStyle().
+		Background(lipgloss.Color("#eb3b5a")).
+		Foreground(lipgloss.Color("#ffffff"))
 
 	tableDefaultSortAscChar  = "▲"
 	tableDefaultSortDescChar = "▼"
@@ -43,6 +54,9 @@ var (
 		StyleKeyRowsSubsequent: tableDefaultRowsSubsequentStyle,
 		StyleKeyRowsCursor:     tableDefaultRowsCursorStyle,
 		StyleKeyCellCursor:     tableDefaultCellCursorStyle,
+		StyleKeyCellEdit:       tableDefaultCellEditStyle,
+		StyleKeyCellEditError:  tableDefaultCellEditErrorStyle,
+		StyleKeyRowsSelected:   tableDefaultRowsSelectedStyle,
 	}
 )
 
@@ -55,34 +69,60 @@ const (
 	StyleKeyRowsSubsequent
 	StyleKeyRowsCursor
 	StyleKeyCellCursor
+	StyleKeyCellEdit
+	StyleKeyCellEditError
+	StyleKeyRowsSelected
 )
 
 // Table responsive, x/y scrollable table that uses magic of FlexBox
 type Table struct {
-	// columnRatio ratio of the columns, is applied to rows as well
-	columnRatio []int
-	// TODO: would be good to add a suffix "array" or "list" to fields of array type
-	// TODO: change type to uint8
-	// columnMinWidth minimal width of the column
-	columnMinWidth []int
-
-	// columnHeaders column text headers
-	// TODO: make this optional, as well as footer
-	columnHeaders []string
-	columnType    []any
-	rows          [][]any
+	// columns holds the definitions of the columns, ratio and widths are applied to rows as well
+	columns []Column
+	// columnOrder holds the indexes of the columns in the order they are rendered in
+	columnOrder []int
+	rows        [][]any
+	// rowKeys holds the key of each of the rows, rowKeyIndex maps the key to the index in rows
+	rowKeys     []RowKey
+	rowKeyIndex map[RowKey]int
+	// lastRowKey is the key given to the most recently added row
+	lastRowKey RowKey
+	// missing holds the indexes of the columns that had no value for the row when it was read from JSON,
+	// the cells hold the zero value of the column type and are left out when writing JSON until they are updated
+	missing map[RowKey]map[int]struct{}
+	// source provides the rows instead of rows when it's set, sourceWindow holds the rows in view
+	// read from it starting at sourceWindowTop, sourceLen is the number of rows in its view
+	source          DataSource
+	sourceLen       int
+	sourceWindow    [][]any
+	sourceWindowTop int
+	// sourceErr is the error of the last filtering done by the data source
+	sourceErr error
 
 	// filteredRows is the rows that are visible after filtering
-	filteredRows   [][]any
-	filteredColumn int
-	filterString   string
-
-	// orderColumnIndex notes which column is used for sorting
-	// -1 means that no column is sorted
-	orderedColumnIndex int
-	// orderedColumnPhase remarks if the sort is asc or desc, basically works like a toggle
-	// 0 indicates desc sorting, 1 indicates
-	orderedColumnPhase SortingOrderKey
+	filteredRows [][]any
+	// filteredIndex holds the index in rows for each of the filtered rows, it's used to keep track of
+	// the row under the cursor when the view changes, nil means filtered rows are the rows as they are
+	filteredIndex []int
+	// rowMatches caches the result of filters for each of the rows, so only the changed rows are matched
+	rowMatches []rowMatch
+	// filters list of column filters in the order they were added
+	filters []Filter
+	// filterOperator decides how multiple column filters are combined
+	filterOperator FilterOperator
+	// filterMode is the mode used for filters that are set without an explicit mode
+	filterMode FilterMode
+	// filterValueMode decides if text filters match raw or formatted cell values
+	filterValueMode ValueMode
+
+	// sortKeys are the keys rows are sorted by, ordered by priority
+	// empty means that no column is sorted
+	sortKeys []SortKey
+	// sortIndex is the permutation of rows indexes for the current sort keys, nil if rows are not sorted
+	// rows are kept in the order they were added in, sortedRows holds them in the sorted order
+	sortIndex  []int
+	sortedRows [][]any
+	// sortCache holds ascending permutations of rows per column index
+	sortCache map[int][]int
 
 	// TODO: rename rowsTopIndex to follow columnVisibleLeftIndex format
 	// rowsTopIndex top visible index
@@ -91,7 +131,8 @@ type Table struct {
 	cursorIndexX    int
 	cursorDirection cursorDirection // not sure if needed
 
-	// columnVisibleLeftIndex and columnVisibleRightIndex are used to calculate the columns on the screen
+	// columnVisibleLeftIndex and columnVisibleRightIndex are used to calculate the columns on the screen,
+	// they are positions in the columnOrder
 	columnVisibleLeftIndex  int
 	columnVisibleRightIndex int
 
@@ -108,86 +149,121 @@ type Table struct {
 
 	headerBox *flexbox.FlexBox
 	rowsBox   *flexbox.FlexBox
+	// headerHidden and footerHidden turn off rendering of the header and footer, rows box takes their space
+	headerHidden    bool
+	footerHidden    bool
+	footerGenerator FooterGenerator
 
 	// these flags indicate weather we should update rows and headers flex boxes
 	updateRowsFlag    bool
 	updateHeadersFlag bool
-}
 
-// NewTable initialize Table object with defaults
+	// autoFit measures the column widths from the content whenever autoFitFlag is set by rows changing,
+	// autoFitSampleSize limits the number of the rows measured, 0 means all
+	autoFit           bool
+	autoFitFlag       bool
+	autoFitSampleSize int
+
+	// rowStyleFunc and cellStyleFunc style the rows and cells depending on their values and state
+	rowStyleFunc  RowStyleFunc
+	cellStyleFunc CellStyleFunc
+
+	// keyMap key bindings used when the table is used as a tea.Model
+	keyMap KeyMap
+	// filtering indicates that the typed keys are used to update the filter
+	filtering bool
+
+	// editing indicates that the typed keys are sent to the editor of the cell
+	// with the row key editKey and column index editColumn
+	editing    bool
+	editor     textinput.Model
+	editKey    RowKey
+	editColumn int
+	// editErr is the error of the last commit attempt, it's shown until the edit is committed or cancelled
+	editErr error
+
+	// selected holds the keys of the selected rows
+	selected map[RowKey]struct{}
+	// selectionAnchor is the key of the row where range selection started, 0 if there is no range
+	// selectionBase is the selection before the range started
+	selectionAnchor RowKey
+	selectionBase   map[RowKey]struct{}
+}
+
+// NewTable initialize Table object with defaults, all the columns are of type string
 func NewTable(width, height int, columnHeaders []string) *Table {
-	var columnRatio, columnMinWidth []int
-	for range columnHeaders {
-		columnRatio = append(columnRatio, 1)
-		columnMinWidth = append(columnMinWidth, 0)
-	}
-
-	// by default all columns are of type string
-	var defaultType string
-	var defaultTypes []any
-	for range columnHeaders {
-		defaultTypes = append(defaultTypes, defaultType)
+	columns := make([]Column, len(columnHeaders))
+	for i, header := range columnHeaders {
+		columns[i] = Column{Header: header, Type: "", Ratio: 1}
 	}
+	return newTable(width, height, columns)
+}
 
+// newTable initialize Table object with the columns that are already validated
+func newTable(width, height int, columns []Column) *Table {
 	styles := tableDefaultStyles
 
 	r := &Table{
-		columnHeaders:           columnHeaders,
-		columnRatio:             columnRatio,
-		columnMinWidth:          columnMinWidth,
-		cursorIndexX:            0,
+		columns:                 columns,
+		columnOrder:             sequence(0, len(columns)),
 		cursorIndexY:            0,
 		cursorDirection:         cursorDirectionUpLeft,
 		columnVisibleLeftIndex:  0,
 		columnVisibleRightIndex: 0,
 
-		columnType:         defaultTypes,
-		orderedColumnIndex: -1,
-		orderedColumnPhase: SortingOrderDescending,
+		sortCache:   make(map[int][]int),
+		rowKeyIndex: make(map[RowKey]int),
+		selected:    make(map[RowKey]struct{}),
 
-		filteredColumn: -1,
-		filterString:   "",
+		filterOperator: FilterOperatorAnd,
+		filterMode:     FilterModeExpression,
 
 		height: height,
 		width:  width,
-		// when optional header/footer is set rework this
-		rowsBoxHeight: height - 2,
 
 		rowsTopIndex: 0,
 		rowHeight:    1,
 
-		headerBox: flexbox.New(width, 1).SetStyle(tableDefaultHeaderStyle),
-		rowsBox:   flexbox.New(width, height-1),
+		headerBox:       flexbox.New(width, 1).SetStyle(tableDefaultHeaderStyle),
+		rowsBox:         flexbox.New(width, height),
+		footerGenerator: DefaultFooter,
 
 		styles:       styles,
 		stylePassing: false,
+
+		keyMap: DefaultKeyMap(),
 	}
+	r.partitionFrozen()
+	r.cursorIndexX = max(0, r.firstVisibleColumn())
+	r.recalculateRowsBoxHeight()
 	r.recalculateVisibleColumnRange()
 	r.setHeadersUpdate()
 	return r
 }
 
-// SetRatio replaces the ratio slice, it has to be exactly the len of the headers/rows slices
+// SetRatio replaces the ratio of the columns, it has to be exactly the len of the headers/rows slices
 // also each value have to be greater than 0, if either fails we panic
 func (r *Table) SetRatio(values []int) *Table {
-	if len(values) != len(r.columnHeaders) {
-		log.Fatalf("ratio list[%d] not of proper length[%d]\n", len(values), len(r.columnHeaders))
+	if len(values) != len(r.columns) {
+		log.Fatalf("ratio list[%d] not of proper length[%d]\n", len(values), len(r.columns))
 	}
 	for _, val := range values {
 		if val < 1 {
 			log.Fatalf("ratio value must be greater than 0")
 		}
 	}
-	r.columnRatio = values
+	for i, val := range values {
+		r.columns[i].Ratio = val
+	}
 	r.setHeadersUpdate()
 	r.setRowsUpdate()
 	return r
 }
 
 // SetTypes sets the column type, setting this will remove all the rows so make sure you do it when instantiating
-// Table object or add new rows after this, types have to be one of Ordered interface types
+// Table object or add new rows after this, types have to be one of the supported cell types, see Column.Type
 func (r *Table) SetTypes(columnTypes ...any) (*Table, error) {
-	if len(columnTypes) != len(r.columnHeaders) {
+	if len(columnTypes) != len(r.columns) {
 		return r, errors.New("column types not the same len as headers")
 	}
 	for i, t := range columnTypes {
@@ -198,20 +274,26 @@ func (r *Table) SetTypes(columnTypes ...any) (*Table, error) {
 			return r, ErrorBadType{msg: message}
 		}
 	}
-	r.cursorIndexY, r.cursorIndexX = 0, 0
-	r.rows = [][]any{}
-	r.columnType = columnTypes
+	r.cursorIndexY, r.cursorIndexX = 0, max(0, r.firstVisibleColumn())
+	r.resetRows()
+	for i, t := range columnTypes {
+		r.columns[i].Type = t
+	}
+	r.resetSort()
+	r.compileFilters()
 	r.setRowsUpdate()
 	return r, nil
 }
 
-// SetMinWidth replaces the minimum width slice, it has to be exactly the len of the headers/rows slices
+// SetMinWidth replaces the minimum width of the columns, it has to be exactly the len of the headers/rows slices
 // if it's not matching len it will trigger fatal error
 func (r *Table) SetMinWidth(values []int) *Table {
-	if len(values) != len(r.columnHeaders) {
-		log.Fatalf("min width list[%d] not of proper length[%d]\n", len(values), len(r.columnHeaders))
+	if len(values) != len(r.columns) {
+		log.Fatalf("min width list[%d] not of proper length[%d]\n", len(values), len(r.columns))
+	}
+	for i, val := range values {
+		r.columns[i].MinWidth = val
 	}
-	r.columnMinWidth = values
 	r.setHeadersUpdate()
 	r.setRowsUpdate()
 	return r
@@ -220,14 +302,35 @@ func (r *Table) SetMinWidth(values []int) *Table {
 // SetHeight sets the height of the table including the header and footer
 func (r *Table) SetHeight(value int) *Table {
 	r.height = value
-	// we deduct two to take header/footer into the account
-	r.rowsBoxHeight = value - 2
-	r.rowsBox.SetHeight(r.rowsBoxHeight)
-	r.setRowsUpdate()
-	r.setTopRow()
+	r.recalculateRowsBoxHeight()
 	return r
 }
 
+// SetHeaderVisible turns rendering of the header on or off, rows take the space of the hidden header
+func (r *Table) SetHeaderVisible(value bool) *Table {
+	r.headerHidden = !value
+	r.recalculateRowsBoxHeight()
+	r.setHeadersUpdate()
+	return r
+}
+
+// IsHeaderVisible returns true if the header is rendered
+func (r *Table) IsHeaderVisible() bool {
+	return !r.headerHidden
+}
+
+// SetFooterVisible turns rendering of the footer on or off, rows take the space of the hidden footer
+func (r *Table) SetFooterVisible(value bool) *Table {
+	r.footerHidden = !value
+	r.recalculateRowsBoxHeight()
+	return r
+}
+
+// IsFooterVisible returns true if the footer is rendered
+func (r *Table) IsFooterVisible() bool {
+	return !r.footerHidden
+}
+
 // SetWidth sets the width of the table
 func (r *Table) SetWidth(value int) *Table {
 	r.width = value
@@ -237,7 +340,8 @@ func (r *Table) SetWidth(value int) *Table {
 	return r
 }
 
-// GetVisibleColumnRange returns the left and right visible column indexes
+// GetVisibleColumnRange returns the left and right visible column positions, see GetColumnOrder for
+// the indexes of the columns on the positions
 func (r *Table) GetVisibleColumnRange() (int, int) {
 	return r.columnVisibleLeftIndex, r.columnVisibleRightIndex
 }
@@ -265,60 +369,81 @@ func (r *Table) SetStylePassing(value bool) *Table {
 	return r
 }
 
-// UnsetFilter resets filtering
-func (r *Table) UnsetFilter() *Table {
-	r.filterString = ""
-	r.filteredColumn = -1
-	r.setTopRow()
-	r.setRowsUpdate()
-	r.setHeadersUpdate()
+// CursorDown move table cursor down
+func (r *Table) CursorDown() *Table {
+	r.resetSelectionRange()
+	if r.cursorIndexY+1 < r.rowCount() {
+		r.cursorDirection = r.cursorDirection.setDown()
+		r.cursorIndexY++
+		r.setTopRow()
+		r.setRowsUpdate()
+	}
 	return r
 }
 
-// SetFilter sets filtering string on a column
-func (r *Table) SetFilter(columnIndex int, s string) *Table {
-	if columnIndex < len(r.columnHeaders) {
-		r.filterString = s
-		r.filteredColumn = columnIndex
-
+// CursorUp move table cursor up
+func (r *Table) CursorUp() *Table {
+	r.resetSelectionRange()
+	if r.cursorIndexY-1 > -1 {
+		r.cursorDirection = r.cursorDirection.setUp()
+		r.cursorIndexY--
+		r.setTopRow()
 		r.setRowsUpdate()
 	}
 	return r
 }
 
-// GetFilter returns string used for filtering and the column index
-// TODO: enable multi column filtering
-func (r *Table) GetFilter() (columnIndex int, s string) {
-	return r.filteredColumn, r.filterString
-}
-
-// CursorDown move table cursor down
-func (r *Table) CursorDown() *Table {
-	if r.cursorIndexY+1 < len(r.filteredRows) {
+// CursorPageDown move table cursor down by the number of visible rows
+func (r *Table) CursorPageDown() *Table {
+	r.resetSelectionRange()
+	if r.rowCount() > 0 && r.rowsBoxHeight > 0 {
 		r.cursorDirection = r.cursorDirection.setDown()
-		r.cursorIndexY++
+		r.cursorIndexY = int(math.Min(float64(r.cursorIndexY+r.rowsBoxHeight), float64(r.rowCount()-1)))
 		r.setTopRow()
 		r.setRowsUpdate()
 	}
 	return r
 }
 
-// CursorUp move table cursor up
-func (r *Table) CursorUp() *Table {
-	if r.cursorIndexY-1 > -1 {
+// CursorPageUp move table cursor up by the number of visible rows
+func (r *Table) CursorPageUp() *Table {
+	r.resetSelectionRange()
+	if r.rowsBoxHeight > 0 {
 		r.cursorDirection = r.cursorDirection.setUp()
-		r.cursorIndexY--
+		r.cursorIndexY = int(math.Max(float64(r.cursorIndexY-r.rowsBoxHeight), 0))
 		r.setTopRow()
 		r.setRowsUpdate()
 	}
 	return r
 }
 
-// CursorLeft move table cursor left
+// CursorTop move table cursor to the first row
+func (r *Table) CursorTop() *Table {
+	r.resetSelectionRange()
+	r.cursorDirection = r.cursorDirection.setUp()
+	r.cursorIndexY = 0
+	r.setTopRow()
+	r.setRowsUpdate()
+	return r
+}
+
+// CursorBottom move table cursor to the last row
+func (r *Table) CursorBottom() *Table {
+	r.resetSelectionRange()
+	if r.rowCount() > 0 {
+		r.cursorDirection = r.cursorDirection.setDown()
+		r.cursorIndexY = r.rowCount() - 1
+		r.setTopRow()
+		r.setRowsUpdate()
+	}
+	return r
+}
+
+// CursorLeft move table cursor left, hidden columns are skipped
 func (r *Table) CursorLeft() *Table {
-	if r.cursorIndexX-1 > -1 {
+	if next := r.nextVisibleColumn(r.cursorIndexX, -1); next > -1 {
 		r.cursorDirection = r.cursorDirection.setLeft()
-		r.cursorIndexX--
+		r.cursorIndexX = next
 		// TODO: update row only
 		r.setRowsUpdate()
 		r.checkVisibleColumnRange()
@@ -326,11 +451,11 @@ func (r *Table) CursorLeft() *Table {
 	return r
 }
 
-// CursorRight move table cursor right
+// CursorRight move table cursor right, hidden columns are skipped
 func (r *Table) CursorRight() *Table {
-	if r.cursorIndexX+1 < len(r.columnHeaders) {
+	if next := r.nextVisibleColumn(r.cursorIndexX, 1); next > -1 {
 		r.cursorDirection = r.cursorDirection.setRight()
-		r.cursorIndexX++
+		r.cursorIndexX = next
 		// TODO: update row only
 		r.setRowsUpdate()
 		r.checkVisibleColumnRange()
@@ -346,15 +471,26 @@ func (r *Table) GetCursorLocation() (int, int) {
 // GetCursorValue returns the string of the cell under the cursor
 func (r *Table) GetCursorValue() string {
 	// handle 0 rows situation and when table is not active
-	if len(r.filteredRows) == 0 || r.cursorIndexX < 0 || r.cursorIndexY < 0 {
+	if r.rowCount() == 0 || r.cursorIndexX < 0 || r.cursorIndexY < 0 {
+		return ""
+	}
+	return getStringFromOrdered(r.viewRow(r.cursorIndexY)[r.cursorIndexX])
+}
+
+// GetCursorFormattedValue returns the string of the cell under the cursor rendered by the column formatter
+func (r *Table) GetCursorFormattedValue() string {
+	if r.rowCount() == 0 || r.cursorIndexX < 0 || r.cursorIndexY < 0 {
 		return ""
 	}
-	return getStringFromOrdered(r.filteredRows[r.cursorIndexY][r.cursorIndexX])
+	return r.formatCell(r.cursorIndexX, r.viewRow(r.cursorIndexY)[r.cursorIndexX])
 }
 
 // AddRows add multiple rows, will return error on the first instance of a row that does not match the type set on table
 // will update rows only when there are no errors
 func (r *Table) AddRows(rows [][]any) (*Table, error) {
+	if r.source != nil {
+		return r, ErrorDataSource{msg: "rows can't be added to the table backed by a data source"}
+	}
 	// check for errors
 	for _, row := range rows {
 		if err := r.validateRow(row...); err != nil {
@@ -362,7 +498,12 @@ func (r *Table) AddRows(rows [][]any) (*Table, error) {
 		}
 	}
 	// append rows
-	r.rows = append(r.rows, rows...)
+	from := len(r.rows)
+	for _, row := range rows {
+		r.appendRow(row)
+	}
+	r.sortAppended(from)
+	r.matchRows(from)
 
 	r.applyFilter()
 	r.setRowsUpdate()
@@ -379,33 +520,42 @@ func (r *Table) MustAddRows(rows [][]any) *Table {
 
 // ClearRows removes all previously added rows, can be used as part of an update loop
 func (r *Table) ClearRows() *Table {
-	r.rows = make([][]any, 0, 10)
+	r.resetRows()
+	r.resetSort()
+	r.applyFilter()
 	r.setRowsUpdate()
 	return r
 }
 
 // Render renders the table into the string
 func (r *Table) Render() string {
+	r.updateAutoFit()
 	r.updateRows()
 	r.updateHeader()
 
-	statusMessage := fmt.Sprintf(
-		"%d:%d / %d:%d ",
-		r.cursorIndexX,
-		r.cursorIndexY,
-		r.rowsBox.GetWidth(),
-		r.rowsBox.GetHeight(),
-	)
-	if r.cursorIndexX == r.filteredColumn {
-		statusMessage = fmt.Sprintf("filtered by: %q / %s", r.filterString, statusMessage)
+	var boxes []string
+	if !r.headerHidden {
+		boxes = append(boxes, r.headerBox.Render())
+	}
+	boxes = append(boxes, r.rowsBox.Render())
+	if !r.footerHidden {
+		boxes = append(boxes, r.renderFooter())
 	}
+	return lipgloss.JoinVertical(lipgloss.Left, boxes...)
+}
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		r.headerBox.Render(),
-		r.rowsBox.Render(),
-		r.styles[StyleKeyFooter].Width(r.width).Render(statusMessage),
-	)
+// recalculateRowsBoxHeight sets the rows box height to the height of the table left after the header and footer
+func (r *Table) recalculateRowsBoxHeight() {
+	r.rowsBoxHeight = r.height
+	if !r.headerHidden {
+		r.rowsBoxHeight--
+	}
+	if !r.footerHidden {
+		r.rowsBoxHeight--
+	}
+	r.rowsBox.SetHeight(r.rowsBoxHeight)
+	r.setRowsUpdate()
+	r.setTopRow()
 }
 
 func (r *Table) setRowsUpdate() {
@@ -429,30 +579,28 @@ func (r *Table) unsetHeadersUpdate() {
 func (r *Table) validateRow(cells ...any) error {
 	var message string
 	// check row len
-	if len(cells) != len(r.columnType) {
+	if len(cells) != len(r.columns) {
 		message = fmt.Sprintf(
-			"len of row[%d] does not equal number of columns[%d]", len(cells), len(r.columnType),
+			"len of row[%d] does not equal number of columns[%d]", len(cells), len(r.columns),
 		)
 		return ErrorRowLen{msg: message}
 	}
 	// check cell type
 	for i, c := range cells {
-		switch c.(type) {
-		case string, int, int8, int16, int32, float32, float64:
-			// check if the cell matches the type of the column
-			if reflect.TypeOf(c) != reflect.TypeOf(r.columnType[i]) {
-				message = fmt.Sprintf(
-					"type of the cell[%v] on index %d not matching type of the column[%v]",
-					reflect.TypeOf(c), i, reflect.TypeOf(r.columnType[i]),
-				)
-				return ErrorBadCellType{msg: message}
-			}
-		default:
+		if !isOrdered(c) {
 			message = fmt.Sprintf(
-				"type[%v] on index %d not matching Ordered interface types", reflect.TypeOf(c), i,
+				"type[%v] on index %d is not one of the supported cell types", reflect.TypeOf(c), i,
 			)
 			return ErrorBadType{msg: message}
 		}
+		// check if the cell matches the type of the column
+		if reflect.TypeOf(c) != reflect.TypeOf(r.columns[i].Type) {
+			message = fmt.Sprintf(
+				"type of the cell[%v] on index %d not matching type of the column[%v]",
+				reflect.TypeOf(c), i, reflect.TypeOf(r.columns[i].Type),
+			)
+			return ErrorBadCellType{msg: message}
+		}
 	}
 	return nil
 }
@@ -465,31 +613,37 @@ func (r *Table) updateHeader() *Table {
 	var cells []*flexbox.Cell
 	r.headerBox.SetStyle(r.styles[StyleKeyHeader])
 
-	leftmostColumnIndex, rightmostColumnIndex := r.columnVisibleLeftIndex, r.columnVisibleRightIndex
+	rightmostColumnIndex := r.columnVisibleRightIndex
 	if r.width == 0 {
 		// this is the case when we initialize the table and width is not set yet
-		rightmostColumnIndex = len(r.columnHeaders) - 1
+		rightmostColumnIndex = len(r.columns) - 1
 	}
-	for index := leftmostColumnIndex; index <= rightmostColumnIndex; index++ {
-		title := r.columnHeaders[index]
+	for _, index := range r.renderedColumns(rightmostColumnIndex) {
+		column := r.columns[index]
+		title := column.Header
 		cells = append(
 			cells,
-			flexbox.NewCell(r.columnRatio[index], 1).SetMinWidth(r.columnMinWidth[index]).SetContentGenerator(func(maxX, maxY int) string {
+			flexbox.NewCell(column.Ratio, 1).SetMinWidth(column.MinWidth).SetMaxWidth(column.MaxWidth).SetContentGenerator(func(maxX, maxY int) string {
 				// titleSuffix at the moment can be sort and filter characters
 				// filtering symbol should be visible always, if possible of course, and as far right as possible
 				// there should be a minimum of space bar between two symbols and symbol and row to the right
 				var titleSuffix string
 				// add sorting symbol if the sorting is active on the column
-				if r.orderedColumnIndex == index {
-					if r.orderedColumnPhase == SortingOrderDescending {
+				// when sorting by multiple keys the symbol is followed by the key priority
+				if i := r.sortKeyIndex(index); i > -1 {
+					if r.sortKeys[i].Order == SortingOrderDescending {
 						titleSuffix = " " + tableDefaultSortDescChar
-					} else if r.orderedColumnPhase == SortingOrderAscending {
+					} else if r.sortKeys[i].Order == SortingOrderAscending {
 						titleSuffix = " " + tableDefaultSortAscChar
 					}
+					if len(r.sortKeys) > 1 {
+						titleSuffix += strconv.Itoa(i + 1)
+					}
 				}
 
 				// add filtering symbol if the filtering is active on the column
-				if r.filteredColumn == index && r.filterString != "" {
+				_, filtered := r.GetColumnFilter(index)
+				if filtered {
 					// add at least one space bar between char to the left, and one to the right
 					titleSuffix = titleSuffix + strings.Repeat(
 						" ", int(math.Max(
@@ -503,15 +657,14 @@ func (r *Table) updateHeader() *Table {
 
 				// if title and suffix exceed width trim the title
 				if maxX-utf8.RuneCountInString(title+titleSuffix) < 0 {
-					// this will be the cae only when sort is on and filter is off
-					// add one space bar between sort and column to the right
-					if utf8.RuneCountInString(titleSuffix) == 2 {
+					// add one space bar between sort and column to the right when filter is off
+					if !filtered && titleSuffix != "" {
 						titleSuffix = titleSuffix + " "
 					}
 					// trim the title
 					title = title[0:int(math.Max(0, float64(maxX-utf8.RuneCountInString(titleSuffix))))]
 				}
-				return title + titleSuffix
+				return r.alignCell(index, title+titleSuffix, maxX)
 			}),
 		)
 	}
@@ -535,45 +688,52 @@ func (r *Table) updateRows() {
 		r.unsetRowsUpdate()
 		return
 	}
-	r.applyFilter()
 
 	// calculate the bottom most visible row index
 	rowsBottomIndex := r.rowsTopIndex + r.rowsBoxHeight
-	if rowsBottomIndex > len(r.filteredRows) {
-		rowsBottomIndex = len(r.filteredRows)
+	if rowsBottomIndex > r.rowCount() {
+		rowsBottomIndex = r.rowCount()
 	}
 
 	var rows []*flexbox.Row
-	for ir, columns := range r.filteredRows[r.rowsTopIndex:rowsBottomIndex] {
+	for ir, columns := range r.visibleRows(r.rowsTopIndex, rowsBottomIndex) {
 		// irCorrected is corrected row index since we iterate only visible rows
 		irCorrected := ir + r.rowsTopIndex
 
+		state := r.rowState(irCorrected)
+		rowStyle := r.rowStyle(state)
 		var cells []*flexbox.Cell
-		for ic, column := range columns[r.columnVisibleLeftIndex : r.columnVisibleRightIndex+1] {
-			icCorrected := ic + r.columnVisibleLeftIndex
+		for _, icCorrected := range r.renderedColumns(r.columnVisibleRightIndex) {
+			// icCorrected is the index of the column rendered on the position
+			value := columns[icCorrected]
+			column := r.columns[icCorrected]
 			// initialize column cell
-			c := flexbox.NewCell(r.columnRatio[ic], r.rowHeight).
-				SetMinWidth(r.columnMinWidth[ic]).
-				SetContent(getStringFromOrdered(column))
-			// update style if cursor is on the cell, otherwise it's inherited from the row
-			if irCorrected == r.cursorIndexY && icCorrected == r.cursorIndexX {
-				c.SetStyle(r.styles[StyleKeyCellCursor])
+			content := r.formatCell(icCorrected, value)
+			c := flexbox.NewCell(column.Ratio, r.rowHeight).
+				SetMinWidth(column.MinWidth).
+				SetMaxWidth(column.MaxWidth).
+				SetContentGenerator(func(maxX, _ int) string { return r.alignCell(icCorrected, content, maxX) })
+			// update style if cell is edited or styled, otherwise it's inherited from the row
+			if r.isEditedCell(r.filteredRowIndex(irCorrected), icCorrected) {
+				c.SetContentGenerator(func(maxX, _ int) string { return r.renderEditor(maxX) })
+				if r.editErr != nil {
+					c.SetStyle(r.styles[StyleKeyCellEditError])
+				} else {
+					c.SetStyle(r.styles[StyleKeyCellEdit])
+				}
+			} else if style, ok := r.cellStyle(CellState{
+				RowState:   state,
+				Column:     icCorrected,
+				Value:      value,
+				CursorCell: state.Cursor && icCorrected == r.cursorIndexX,
+			}, rowStyle); ok {
+				c.SetStyle(style)
 			}
 			cells = append(cells, c)
 		}
 		// initialize new row from the rows box and add generated cells
-		rw := r.rowsBox.NewRow().StylePassing(r.stylePassing).AddCells(cells...)
-
-		// rows have three styles, normal, subsequent and selected
-		// normal and subsequent rows should differ for readability
-		// TODO: make this ^ optional
-		if irCorrected == r.cursorIndexY {
-			rw.SetStyle(r.styles[StyleKeyRowsCursor])
-		} else if irCorrected%2 == 0 || irCorrected == 0 {
-			rw.SetStyle(r.styles[StyleKeyRowsSubsequent])
-		} else {
-			rw.SetStyle(r.styles[StyleKeyRows])
-		}
+		// rows are styled in layers, striping, row style func, selected and the one under the cursor
+		rw := r.rowsBox.NewRow().StylePassing(r.stylePassing).AddCells(cells...).SetStyle(rowStyle)
 
 		rows = append(rows, rw)
 	}
@@ -584,62 +744,40 @@ func (r *Table) updateRows() {
 	r.unsetRowsUpdate()
 }
 
-// applyFilter filters column n by a value s
-func (r *Table) applyFilter() *Table {
-	// sending empty string should reset the filtering
-	if r.filterString == "" {
-		r.filteredRows = r.rows
-		return r
-	}
-	var filteredRows [][]any
-	for _, row := range r.rows {
-		cellValue := getStringFromOrdered(row[r.filteredColumn])
-		// convert to lower, not sure if anybody needs case-sensitive filtering
-		// if you are reading this and need it, open up an issue :zap:
-		if strings.Contains(strings.ToLower(cellValue), strings.ToLower(r.filterString)) {
-			filteredRows = append(filteredRows, row)
-		}
-	}
-	r.filteredRows = filteredRows
-	r.setTopRow()
-	r.setHeadersUpdate()
-	return r
-}
-
 // setTopRow calculates the row top index used when deciding what is visible
 func (r *Table) setTopRow() {
 	// if rows are empty set y to 0, retain x pos
 	// will be useful for filtering
-	if len(r.filteredRows) == 0 {
+	if r.rowCount() == 0 {
 		r.cursorIndexY = 0
-	} else if r.cursorIndexY > len(r.filteredRows) {
+	} else if r.cursorIndexY >= r.rowCount() {
 		// when filtering if cursor is higher than row length
 		// set it to the bottom of the list
-		r.cursorIndexY = len(r.filteredRows) - 1
+		r.cursorIndexY = r.rowCount() - 1
 	}
 
 	// case when cursor is in between top or bottom visible row
 	if r.cursorIndexY >= r.rowsTopIndex && r.cursorIndexY < r.rowsTopIndex+r.rowsBoxHeight {
 		// if cursor is on the last item in row, adjust the row top
-		if r.cursorIndexY == len(r.filteredRows)-1 {
+		if r.cursorIndexY == r.rowCount()-1 {
 			// if all rows can fit on screen
-			if len(r.filteredRows) <= r.rowsBoxHeight {
+			if r.rowCount() <= r.rowsBoxHeight {
 				r.rowsTopIndex = 0
 				return
 			}
 			// fit max rows on the table
 			r.rowsTopIndex = r.cursorIndexY - (r.rowsBoxHeight - 1)
-		} else if r.cursorIndexY > len(r.filteredRows)-1 && len(r.filteredRows) != 0 {
-			r.cursorIndexY = len(r.filteredRows) - 1
+		} else if r.cursorIndexY > r.rowCount()-1 && r.rowCount() != 0 {
+			r.cursorIndexY = r.rowCount() - 1
 		}
 		return
 	}
 
 	// if cursor is above the top
 	if r.cursorIndexY < r.rowsTopIndex {
-		if r.cursorIndexY == len(r.filteredRows)-1 {
+		if r.cursorIndexY == r.rowCount()-1 {
 			// if all rows can fit on screen
-			if len(r.filteredRows) <= r.rowsBoxHeight {
+			if r.rowCount() <= r.rowsBoxHeight {
 				r.rowsTopIndex = 0
 				return
 			}
@@ -658,48 +796,76 @@ func (r *Table) setTopRow() {
 }
 
 func (r *Table) recalculateVisibleColumnRange() {
-	var totalWidth int
+	// frozen columns are always rendered so their width is taken from the budget first
+	totalWidth := r.frozenWidth()
 	r.setRowsUpdate()
 	r.setHeadersUpdate()
+	cursorPosition := r.columnPosition(r.cursorIndexX)
+	if frozen := r.frozenCount(); cursorPosition < frozen {
+		// cursor is on a frozen column, scrolled columns stay where they are
+		r.columnVisibleLeftIndex = max(r.columnVisibleLeftIndex, frozen)
+		r.columnVisibleRightIndex, _ = r.columnIndexSeekRight(r.columnVisibleLeftIndex, totalWidth)
+		return
+	}
 	if r.cursorDirection.isRight() {
-		r.columnVisibleLeftIndex, totalWidth = r.columnIndexSeekLeft(r.cursorIndexX, 0)
-		r.columnVisibleRightIndex, totalWidth = r.columnIndexSeekRight(r.cursorIndexX+1, totalWidth)
+		r.columnVisibleLeftIndex, totalWidth = r.columnIndexSeekLeft(cursorPosition, totalWidth)
+		r.columnVisibleRightIndex, totalWidth = r.columnIndexSeekRight(cursorPosition+1, totalWidth)
 	} else {
-		r.columnVisibleRightIndex, totalWidth = r.columnIndexSeekRight(r.cursorIndexX, totalWidth)
-		r.columnVisibleLeftIndex, totalWidth = r.columnIndexSeekLeft(r.cursorIndexX-1, totalWidth)
+		r.columnVisibleRightIndex, totalWidth = r.columnIndexSeekRight(cursorPosition, totalWidth)
+		r.columnVisibleLeftIndex, totalWidth = r.columnIndexSeekLeft(cursorPosition-1, totalWidth)
 	}
 	return
 }
 
+// columnIndexSeekLeft seeks the leftmost position that fits the width starting from the position,
+// hidden columns take no width and the seek stops at the frozen columns
 func (r *Table) columnIndexSeekLeft(index int, widthAdded int) (int, int) {
-	for i := index; i >= 0; i-- {
-		if widthAdded+r.columnMinWidth[i] > r.width {
+	frozen := r.frozenCount()
+	for i := index; i >= frozen; i-- {
+		column := r.columns[r.columnOrder[i]]
+		if column.Hidden {
+			if i == frozen {
+				return i, widthAdded
+			}
+			continue
+		}
+		if widthAdded+column.MinWidth > r.width {
 			return i + 1, widthAdded
 		}
-		widthAdded += r.columnMinWidth[i]
-		if widthAdded == r.width || i == 0 {
+		widthAdded += column.MinWidth
+		if widthAdded == r.width || i == frozen {
 			return i, widthAdded
 		}
 	}
-	return 0, widthAdded
+	return frozen, widthAdded
 }
 
+// columnIndexSeekRight seeks the rightmost position that fits the width starting from the position,
+// hidden columns take no width
 func (r *Table) columnIndexSeekRight(index int, widthAdded int) (int, int) {
-	for i := index; i < len(r.columnHeaders); i++ {
-		if widthAdded+r.columnMinWidth[i] > r.width {
+	for i := index; i < len(r.columns); i++ {
+		column := r.columns[r.columnOrder[i]]
+		if column.Hidden {
+			if i == len(r.columns)-1 {
+				return i, widthAdded
+			}
+			continue
+		}
+		if widthAdded+column.MinWidth > r.width {
 			return i - 1, widthAdded
 		}
-		widthAdded += r.columnMinWidth[i]
-		if widthAdded == r.width || i == len(r.columnHeaders)-1 {
+		widthAdded += column.MinWidth
+		if widthAdded == r.width || i == len(r.columns)-1 {
 			return i, widthAdded
 		}
 	}
-	return len(r.columnHeaders) - 1, widthAdded
+	return len(r.columns) - 1, widthAdded
 }
 
 // checkVisibleColumnRange should be executed only after the cursor is moved left or right
 func (r *Table) checkVisibleColumnRange() {
-	if r.cursorIndexX < r.columnVisibleLeftIndex || r.cursorIndexX > r.columnVisibleRightIndex {
+	p := r.columnPosition(r.cursorIndexX)
+	if p >= r.frozenCount() && (p < r.columnVisibleLeftIndex || p > r.columnVisibleRightIndex) {
 		r.recalculateVisibleColumnRange()
 	}
 	return
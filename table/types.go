@@ -0,0 +1,50 @@
+package table
+
+import (
+	"time"
+)
+
+// CellValue is implemented by custom types that are used as column types, table uses String
+// to render, filter and export the values and Compare to sort them
+type CellValue interface {
+	String() string
+	// Compare returns -1 if the value is less than the other, 0 if they are equal and +1 if it's greater,
+	// other is always of the same type as the value
+	Compare(other CellValue) int
+}
+
+// CellValueParser can be implemented by custom cell types to parse the values from strings, it makes
+// typed filter expressions and cell editing possible, without it the values are filtered as text
+type CellValueParser interface {
+	Parse(s string) (CellValue, error)
+}
+
+// timeLayouts are the layouts time.Time values are parsed with, first one is used to render them
+var timeLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+// parseTime parses the time in any of the supported layouts
+func parseTime(s string) (time.Time, error) {
+	var err error
+	for _, layout := range timeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// isTextType checks if the values of the type are matched as text by the plain filter expressions,
+// the rest of the types are matched by equality
+func isTextType(columnType any) bool {
+	switch columnType.(type) {
+	case string, time.Time:
+		return true
+	case CellValueParser:
+		return false
+	case CellValue:
+		return true
+	default:
+		return false
+	}
+}